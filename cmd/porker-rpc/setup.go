@@ -2,47 +2,150 @@ package main
 
 import (
 	"context"
+	"net/http"
 
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/swallowarc/porker-proto/pkg/porker"
 	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
 	"github.com/swallowarc/porker-rpc/internal/infrastructures"
 	"github.com/swallowarc/porker-rpc/internal/infrastructures/env"
 	"github.com/swallowarc/porker-rpc/internal/infrastructures/grpc_server"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/grpc_server/interceptors"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/grpc_web"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/metrics"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/redis"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/rest_gateway"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/room_feed"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/tracing"
 	"github.com/swallowarc/porker-rpc/internal/interface_adapters/controllers"
 	"github.com/swallowarc/porker-rpc/internal/interface_adapters/repositories"
 	"github.com/swallowarc/porker-rpc/internal/usecases/interactors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 func setup() grpc_server.GRPCServer {
 	zapLogger := loggers.NewZapLogger(env.Server.IsDevelopment)
 
+	// tracing
+	shutdownTracing, err := tracing.Setup(context.Background(), env.OTel)
+	if err != nil {
+		zapLogger.Panic("failed to setup tracing", zap.Error(err))
+	}
+
+	// room keys are hash tagged only for Redis Cluster, so that other deployments keep their live rooms
+	roomKeys := room.NewKeyStrategy(env.Server.MemDB == env.MemDBRedis && env.Redis.Mode == redis.ModeCluster)
+
 	// factories
 	gwFactory := infrastructures.NewFactory()
-	repoFactory := repositories.NewFactory(gwFactory)
-	iFactory := interactors.NewFactory(repoFactory)
+	repoFactory := repositories.NewFactory(gwFactory, repositories.SituationFormat(env.Server.StreamPayloadFormat), roomKeys)
+	iFactory := interactors.NewFactory(repoFactory, metrics.NewPokerMetrics(prometheus.DefaultRegisterer))
 
 	// interface_adapters
 	controller := controllers.NewPorkerController(zapLogger, iFactory)
 	// grpc_controller_register
 	grpcControllerRegisters := grpc_server.ControllerRegisters{
 		grpc_server.NewControllerRegister(controller),
-		grpc_server.NewHealthRegister(gwFactory.MemDBClient()),
+		grpc_server.NewHealthRegister(
+			zapLogger,
+			gwFactory.MemDBClient(),
+			env.Server.HealthProbeInterval,
+			porker.PorkerService_ServiceDesc.ServiceName,
+		),
+	}
+
+	// interceptors
+	publicMethods := interceptors.PublicMethods{
+		"/porker.PorkerService/Login",
+		"/grpc.health.v1.Health/",
+		"/grpc.reflection.v1alpha.ServerReflection/",
+	}
+	grpc_prometheus.EnableHandlingTimeHistogram()
+	grpcInterceptors := grpc_server.Interceptors{
+		Unary: []grpc.UnaryServerInterceptor{
+			otelgrpc.UnaryServerInterceptor(),
+			interceptors.RecoveryUnaryServerInterceptor(),
+			grpc_prometheus.UnaryServerInterceptor,
+			interceptors.ClientIdentityUnaryServerInterceptor(),
+			interceptors.StatusUnaryServerInterceptor(),
+			interceptors.AuthUnaryServerInterceptor(iFactory.LoginInteractor(), publicMethods),
+		},
+		Stream: []grpc.StreamServerInterceptor{
+			otelgrpc.StreamServerInterceptor(),
+			interceptors.RecoveryStreamServerInterceptor(),
+			grpc_prometheus.StreamServerInterceptor,
+			interceptors.ClientIdentityStreamServerInterceptor(),
+			interceptors.StatusStreamServerInterceptor(),
+			interceptors.AuthStreamServerInterceptor(iFactory.LoginInteractor(), publicMethods),
+		},
+	}
+
+	// gateways
+	var grpcGateways grpc_server.Gateways
+	if env.GRPCWeb.Enabled {
+		grpcGateways = append(grpcGateways, grpc_web.NewGRPCWebGateway(zapLogger, env.GRPCWeb))
+	}
+	if env.REST.Enabled {
+		grpcGateways = append(grpcGateways, rest_gateway.NewRESTGateway(zapLogger, env.REST))
+	}
+	if env.RoomFeed.Enabled {
+		feedController := controllers.NewRoomFeedController(zapLogger, iFactory, env.RoomFeed.AllowedOrigins)
+		grpcGateways = append(grpcGateways, room_feed.NewRoomFeedGateway(zapLogger, env.RoomFeed, feedController))
+	}
+
+	// metrics
+	var metricsServer *http.Server
+	if env.Server.MetricsEnabled {
+		metricsServer = metrics.NewHTTPServer(env.Server.MetricsPort, prometheus.DefaultGatherer)
 	}
 
 	// initializer & closer
 	init := func() {
 		if err := gwFactory.MemDBClient().Ping(context.Background()); err != nil {
-			zapLogger.Panic("failed to ping to redis", zap.Error(err))
+			zapLogger.Panic("failed to ping to mem db", zap.Error(err))
+		}
+		zapLogger.Info("ping to mem db was successful")
+
+		if metricsServer != nil {
+			go func() {
+				zapLogger.Info("metrics server start", zap.String("port", env.Server.MetricsPort))
+				if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					zapLogger.Error("failed to serve metrics", zap.Error(err))
+				}
+			}()
+		}
+	}
+	closer := func() {
+		// the deadline timers write the rooms until they stop
+		iFactory.Close()
+		if metricsServer != nil {
+			if err := metricsServer.Shutdown(context.Background()); err != nil {
+				zapLogger.Error("failed to shutdown metrics server", zap.Error(err))
+			}
+		}
+		if err := gwFactory.Close(); err != nil {
+			zapLogger.Error("failed to close gateways", zap.Error(err))
+		}
+		if err := shutdownTracing(context.Background()); err != nil {
+			zapLogger.Error("failed to shutdown tracing", zap.Error(err))
 		}
-		zapLogger.Info("ping to redis was successful")
 	}
-	closer := func() {}
 
 	grpcServer := grpc_server.NewGRPCServer(
 		zapLogger,
 		env.Server.PORT,
 		env.Server.IsDevelopment,
+		grpc_server.TLSConfig{
+			CertFile:     env.Server.TLSCertFile,
+			KeyFile:      env.Server.TLSKeyFile,
+			ClientCAFile: env.Server.TLSClientCAFile,
+		},
 		grpcControllerRegisters,
+		grpcInterceptors,
+		grpcGateways,
 		init,
 		closer,
 	)
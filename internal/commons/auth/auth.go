@@ -0,0 +1,22 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+)
+
+type (
+	loginKey struct{}
+)
+
+// LoginToContext stores the authenticated login in the context.
+func LoginToContext(ctx context.Context, login *porker.Login) context.Context {
+	return context.WithValue(ctx, loginKey{}, login)
+}
+
+// Login returns the authenticated login stored by LoginToContext.
+func Login(ctx context.Context) (*porker.Login, bool) {
+	login, ok := ctx.Value(loginKey{}).(*porker.Login)
+	return login, ok
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net/url"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// ForwardedClientCertKey is the metadata key of the client certificate verified by a gateway in the process.
+const ForwardedClientCertKey = "porker-forwarded-client-cert-bin"
+
+type (
+	// ClientIdentity is the subject of the client certificate verified by mTLS.
+	ClientIdentity struct {
+		CommonName   string
+		DNSNames     []string
+		URIs         []*url.URL
+		SerialNumber *big.Int
+	}
+
+	// InProcessAuthInfo is the AuthInfo of the connections of the gateways dialing the server in the process.
+	// Only these connections are trusted to forward the client certificate with ForwardedClientCertKey.
+	InProcessAuthInfo struct {
+		credentials.CommonAuthInfo
+	}
+)
+
+func (InProcessAuthInfo) AuthType() string {
+	return "in-process"
+}
+
+// ForwardedClientCert returns the metadata forwarding the verified client certificate of a connection of a gateway.
+// It returns nil when the connection does not use mTLS.
+func ForwardedClientCert(state *tls.ConnectionState) metadata.MD {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return metadata.Pairs(ForwardedClientCertKey, string(state.VerifiedChains[0][0].Raw))
+}
+
+// ClientIdentityFromContext returns the identity of the verified client certificate of the connection,
+// or the one forwarded by a gateway in the process.
+// It returns false when the connection does not use mTLS, e.g. plaintext connections.
+func ClientIdentityFromContext(ctx context.Context) (*ClientIdentity, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	var cert *x509.Certificate
+	switch info := p.AuthInfo.(type) {
+	case credentials.TLSInfo:
+		chains := info.State.VerifiedChains
+		if len(chains) == 0 || len(chains[0]) == 0 {
+			return nil, false
+		}
+		cert = chains[0][0]
+	case InProcessAuthInfo:
+		// the gateway has verified the certificate, and clients cannot reach the in-process connection
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(ForwardedClientCertKey)
+		if len(values) == 0 {
+			return nil, false
+		}
+		var err error
+		if cert, err = x509.ParseCertificate([]byte(values[0])); err != nil {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	return &ClientIdentity{
+		CommonName:   cert.Subject.CommonName,
+		DNSNames:     cert.DNSNames,
+		URIs:         cert.URIs,
+		SerialNumber: cert.SerialNumber,
+	}, true
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/swallowarc/porker-rpc/internal/tests/testcerts"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClientIdentityFromContext(t *testing.T) {
+	ca := testcerts.NewCA(t)
+	certPEM, keyPEM := ca.Issue(t, 3, "porker-client", x509.ExtKeyUsageClientAuth)
+	pair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("failed to X509KeyPair: %v", err)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		t.Fatalf("failed to ParseCertificate: %v", err)
+	}
+	state := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+
+	withPeer := func(info credentials.AuthInfo, md metadata.MD) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: info})
+		return metadata.NewIncomingContext(ctx, md)
+	}
+
+	for name, tc := range map[string]struct {
+		ctx      context.Context
+		expected string
+	}{
+		"mTLS":                      {ctx: withPeer(credentials.TLSInfo{State: *state}, nil), expected: "porker-client"},
+		"TLS without certificate":   {ctx: withPeer(credentials.TLSInfo{}, nil)},
+		"forwarded by gateway":      {ctx: withPeer(InProcessAuthInfo{}, ForwardedClientCert(state)), expected: "porker-client"},
+		"gateway without mTLS":      {ctx: withPeer(InProcessAuthInfo{}, ForwardedClientCert(&tls.ConnectionState{}))},
+		"forwarded by TLS client":   {ctx: withPeer(credentials.TLSInfo{}, ForwardedClientCert(state))},
+		"forwarded by plain client": {ctx: withPeer(nil, ForwardedClientCert(state))},
+		"forged by gateway client":  {ctx: withPeer(InProcessAuthInfo{}, metadata.Pairs(ForwardedClientCertKey, "forged"))},
+		"no peer":                   {ctx: context.Background()},
+	} {
+		id, ok := ClientIdentityFromContext(tc.ctx)
+		if ok != (tc.expected != "") {
+			t.Errorf("%s: expected identity %v, actual %v", name, tc.expected != "", ok)
+			continue
+		}
+		if ok && (id.CommonName != tc.expected || id.SerialNumber.Int64() != 3) {
+			t.Errorf("%s: unexpected identity: %+v", name, id)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package errs
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	AlreadyExistsError struct {
+		error
+	}
+)
+
+func NewAlreadyExistsError(text string) AlreadyExistsError {
+	return AlreadyExistsError{error: xerrors.New(text)}
+}
+
+func IsAlreadyExistsError(err error) bool {
+	return xerrors.As(err, &AlreadyExistsError{})
+}
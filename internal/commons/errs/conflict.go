@@ -0,0 +1,19 @@
+package errs
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	ConflictError struct {
+		error
+	}
+)
+
+func NewConflictError(text string) ConflictError {
+	return ConflictError{error: xerrors.New(text)}
+}
+
+func IsConflictError(err error) bool {
+	return xerrors.As(err, &ConflictError{})
+}
@@ -0,0 +1,19 @@
+package errs
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	InvalidArgumentError struct {
+		error
+	}
+)
+
+func NewInvalidArgumentError(text string) InvalidArgumentError {
+	return InvalidArgumentError{error: xerrors.New(text)}
+}
+
+func IsInvalidArgumentError(err error) bool {
+	return xerrors.As(err, &InvalidArgumentError{})
+}
@@ -0,0 +1,19 @@
+package errs
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	InvalidStateError struct {
+		error
+	}
+)
+
+func NewInvalidStateError(text string) InvalidStateError {
+	return InvalidStateError{error: xerrors.New(text)}
+}
+
+func IsInvalidStateError(err error) bool {
+	return xerrors.As(err, &InvalidStateError{})
+}
@@ -0,0 +1,19 @@
+package errs
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	PermissionDeniedError struct {
+		error
+	}
+)
+
+func NewPermissionDeniedError(text string) PermissionDeniedError {
+	return PermissionDeniedError{error: xerrors.New(text)}
+}
+
+func IsPermissionDeniedError(err error) bool {
+	return xerrors.As(err, &PermissionDeniedError{})
+}
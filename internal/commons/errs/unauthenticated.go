@@ -0,0 +1,19 @@
+package errs
+
+import (
+	"golang.org/x/xerrors"
+)
+
+type (
+	UnauthenticatedError struct {
+		error
+	}
+)
+
+func NewUnauthenticatedError(text string) UnauthenticatedError {
+	return UnauthenticatedError{error: xerrors.New(text)}
+}
+
+func IsUnauthenticatedError(err error) bool {
+	return xerrors.As(err, &UnauthenticatedError{})
+}
@@ -2,10 +2,15 @@ package random
 
 import (
 	"math/rand"
+	"sync"
 	"time"
 )
 
-var randSrc = rand.NewSource(time.Now().UnixNano())
+var (
+	// rand.Source は並行に使えないので randMu で守る
+	randMu  sync.Mutex
+	randSrc = rand.NewSource(time.Now().UnixNano())
+)
 
 const (
 	rs6Letters       = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ1234567890"
@@ -22,10 +27,10 @@ func RandString6(n int) string {
 // RandString6 指定されたパターンと長さのランダムな文字列を生成.
 func RandString6ByParam(n int, pattern string) string {
 	b := make([]byte, n)
-	cache, remain := randSrc.Int63(), rs6LetterIdxMax
+	cache, remain := int63(), rs6LetterIdxMax
 	for i := n - 1; i >= 0; {
 		if remain == 0 {
-			cache, remain = randSrc.Int63(), rs6LetterIdxMax
+			cache, remain = int63(), rs6LetterIdxMax
 		}
 		idx := int(cache & rs6LetterIdxMask)
 		if idx < len(pattern) {
@@ -37,3 +42,9 @@ func RandString6ByParam(n int, pattern string) string {
 	}
 	return string(b)
 }
+
+func int63() int64 {
+	randMu.Lock()
+	defer randMu.Unlock()
+	return randSrc.Int63()
+}
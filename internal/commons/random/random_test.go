@@ -2,6 +2,7 @@ package random
 
 import (
 	"regexp"
+	"sync"
 	"testing"
 )
 
@@ -14,3 +15,20 @@ func TestRandString6(t *testing.T) {
 		t.Errorf("英数字以外の文字が混在")
 	}
 }
+
+func TestRandString6_Concurrent(t *testing.T) {
+	// go test -race で randSrc の競合を検出する
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if actual := RandString6(8); len(actual) != 8 {
+					t.Errorf("expected %d, actual %d", 8, len(actual))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,39 @@
+package tracers
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/swallowarc/porker-rpc"
+
+	RoomIDKey  = attribute.Key("room_id")
+	LoginIDKey = attribute.Key("login_id")
+)
+
+// Start starts a span as a child of the span in ctx by the global tracer provider.
+func Start(ctx context.Context, spanName string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, spanName, trace.WithAttributes(attrs...))
+}
+
+// End records err to the span if any and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func RoomID(roomID string) attribute.KeyValue {
+	return RoomIDKey.String(roomID)
+}
+
+func LoginID(loginID string) attribute.KeyValue {
+	return LoginIDKey.String(loginID)
+}
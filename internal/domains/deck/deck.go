@@ -0,0 +1,193 @@
+package deck
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	MaxCards       = 30
+	MaxLabelLength = 20
+
+	LabelQuestion = "?"
+	LabelCoffee   = "coffee"
+)
+
+type (
+	card struct {
+		label string
+		value *float64
+	}
+)
+
+var (
+	// pointLabels are the labels of the cards that older clients vote with porker.Point.
+	pointLabels = map[porker.Point]string{
+		porker.Point_POINT_0:        "0",
+		porker.Point_POINT_HALF:     "1/2",
+		porker.Point_POINT_1:        "1",
+		porker.Point_POINT_2:        "2",
+		porker.Point_POINT_3:        "3",
+		porker.Point_POINT_5:        "5",
+		porker.Point_POINT_8:        "8",
+		porker.Point_POINT_13:       "13",
+		porker.Point_POINT_21:       "21",
+		porker.Point_POINT_COFFEE:   LabelCoffee,
+		porker.Point_POINT_QUESTION: LabelQuestion,
+	}
+
+	builtins = map[porker.DeckType][]card{
+		porker.DeckType_DECK_TYPE_FIBONACCI: numbers([]string{"0", "1/2", "1", "2", "3", "5", "8", "13", "21"},
+			[]float64{0, 0.5, 1, 2, 3, 5, 8, 13, 21}),
+		porker.DeckType_DECK_TYPE_MODIFIED_FIBONACCI: numbers([]string{"0", "1/2", "1", "2", "3", "5", "8", "13", "20", "40", "100"},
+			[]float64{0, 0.5, 1, 2, 3, 5, 8, 13, 20, 40, 100}),
+		// T-shirt sizes are valued as a Fibonacci scale so that the statistics can still be computed.
+		porker.DeckType_DECK_TYPE_T_SHIRT: numbers([]string{"XS", "S", "M", "L", "XL", "XXL"},
+			[]float64{1, 2, 3, 5, 8, 13}),
+		porker.DeckType_DECK_TYPE_POWERS_OF_TWO: numbers([]string{"0", "1", "2", "4", "8", "16", "32", "64"},
+			[]float64{0, 1, 2, 4, 8, 16, 32, 64}),
+	}
+)
+
+func numbers(labels []string, values []float64) []card {
+	cards := make([]card, 0, len(labels)+2)
+	for i := range labels {
+		v := values[i]
+		cards = append(cards, card{label: labels[i], value: &v})
+	}
+	// every built-in deck has the cards without a value
+	return append(cards, card{label: LabelQuestion}, card{label: LabelCoffee})
+}
+
+// New returns the deck of a new room. The cards of the built-in decks are filled in,
+// and the cards of a custom deck are validated.
+func New(d *porker.Deck) (*porker.Deck, error) {
+	t := d.GetType()
+	if t == porker.DeckType_DECK_TYPE_UNKNOWN {
+		t = porker.DeckType_DECK_TYPE_FIBONACCI
+	}
+
+	if t != porker.DeckType_DECK_TYPE_CUSTOM {
+		cards, ok := builtins[t]
+		if !ok {
+			return nil, errs.NewInvalidArgumentError(fmt.Sprintf("unknown deck type: %s", t))
+		}
+		if len(d.GetCards()) > 0 {
+			return nil, errs.NewInvalidArgumentError(fmt.Sprintf("cards cannot be given to the built-in deck: %s", t))
+		}
+		newDeck := &porker.Deck{Type: t, Cards: make([]*porker.Card, 0, len(cards))}
+		for _, c := range cards {
+			pc := &porker.Card{Label: c.label}
+			if c.value != nil {
+				pc.Value = wrapperspb.Double(*c.value)
+			}
+			newDeck.Cards = append(newDeck.Cards, pc)
+		}
+		return newDeck, nil
+	}
+
+	if err := validate(d.GetCards()); err != nil {
+		return nil, err
+	}
+	newDeck := &porker.Deck{Type: t, Cards: make([]*porker.Card, 0, len(d.Cards))}
+	for _, c := range d.Cards {
+		newDeck.Cards = append(newDeck.Cards, &porker.Card{Label: strings.TrimSpace(c.Label), Value: c.Value})
+	}
+	return newDeck, nil
+}
+
+func validate(cards []*porker.Card) error {
+	if len(cards) == 0 || len(cards) > MaxCards {
+		return errs.NewInvalidArgumentError(fmt.Sprintf("a custom deck must have 1 to %d cards: %d", MaxCards, len(cards)))
+	}
+
+	labels := make(map[string]struct{}, len(cards))
+	for _, c := range cards {
+		label := strings.TrimSpace(c.GetLabel())
+		if label == "" || utf8.RuneCountInString(label) > MaxLabelLength {
+			return errs.NewInvalidArgumentError(fmt.Sprintf("a card label must have 1 to %d characters: %q", MaxLabelLength, c.GetLabel()))
+		}
+		if _, ok := labels[label]; ok {
+			return errs.NewInvalidArgumentError(fmt.Sprintf("card labels must be unique: %q", label))
+		}
+		labels[label] = struct{}{}
+
+		if v := c.GetValue(); v != nil && (math.IsNaN(v.Value) || math.IsInf(v.Value, 0)) {
+			return errs.NewInvalidArgumentError(fmt.Sprintf("a card value must be a finite number: %q", label))
+		}
+	}
+	return nil
+}
+
+// OrDefault returns the deck of the room, or the Fibonacci deck for rooms created without one.
+func OrDefault(d *porker.Deck) *porker.Deck {
+	if d != nil {
+		return d
+	}
+	newDeck, _ := New(nil)
+	return newDeck
+}
+
+// Find returns the card of the label in the deck.
+func Find(d *porker.Deck, label string) (*porker.Card, bool) {
+	for _, c := range d.GetCards() {
+		if c.Label == label {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+// CardOf returns the card of the ballot in the deck. Ballots voted before the rooms had decks only have a point.
+func CardOf(d *porker.Deck, b *porker.Ballot) (*porker.Card, bool) {
+	label := b.GetCard()
+	if label == "" {
+		label = pointLabels[b.GetPoint()]
+	}
+	if label == "" {
+		return nil, false
+	}
+	return Find(d, label)
+}
+
+// Resolve validates a vote given by either a point or a card label against the deck,
+// and returns both the point and the label to store in the ballot.
+// NOT_VOTE and POINT_UNKNOWN do not hold a card.
+func Resolve(d *porker.Deck, point porker.Point, label string) (porker.Point, string, error) {
+	switch {
+	case point == porker.Point_NOT_VOTE:
+		return porker.Point_NOT_VOTE, "", nil
+	case label != "":
+		if _, ok := Find(d, label); !ok {
+			return porker.Point_POINT_UNKNOWN, "", errs.NewInvalidArgumentError(fmt.Sprintf("card is not in the deck: %q", label))
+		}
+		return pointOf(label), label, nil
+	case point == porker.Point_POINT_UNKNOWN:
+		return porker.Point_POINT_UNKNOWN, "", nil
+	}
+
+	label, ok := pointLabels[point]
+	if !ok {
+		return porker.Point_POINT_UNKNOWN, "", errs.NewInvalidArgumentError(fmt.Sprintf("point needs a card: %s", point))
+	}
+	if _, ok := Find(d, label); !ok {
+		return porker.Point_POINT_UNKNOWN, "", errs.NewInvalidArgumentError(fmt.Sprintf("point is not in the deck: %s", point))
+	}
+	return point, label, nil
+}
+
+// pointOf returns the point of the label for older clients, or POINT_CARD if there is none.
+func pointOf(label string) porker.Point {
+	for p, l := range pointLabels {
+		if l == label {
+			return p
+		}
+	}
+	return porker.Point_POINT_CARD
+}
@@ -0,0 +1,140 @@
+package deck
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		d, err := New(nil)
+		if err != nil {
+			t.Fatalf("failed to New: %v", err)
+		}
+		if d.Type != porker.DeckType_DECK_TYPE_FIBONACCI {
+			t.Errorf("expected %s, actual %s", porker.DeckType_DECK_TYPE_FIBONACCI, d.Type)
+		}
+		// every point of older clients is in the default deck
+		for p, label := range pointLabels {
+			if _, ok := Find(d, label); !ok {
+				t.Errorf("%s is not in the default deck", p)
+			}
+		}
+	})
+
+	t.Run("built-in", func(t *testing.T) {
+		for _, typ := range []porker.DeckType{
+			porker.DeckType_DECK_TYPE_FIBONACCI,
+			porker.DeckType_DECK_TYPE_MODIFIED_FIBONACCI,
+			porker.DeckType_DECK_TYPE_T_SHIRT,
+			porker.DeckType_DECK_TYPE_POWERS_OF_TWO,
+		} {
+			d, err := New(&porker.Deck{Type: typ})
+			if err != nil {
+				t.Fatalf("failed to New %s: %v", typ, err)
+			}
+			if err := validate(d.Cards); err != nil {
+				t.Errorf("%s is not valid: %v", typ, err)
+			}
+			if c, ok := Find(d, LabelQuestion); !ok || c.Value != nil {
+				t.Errorf("%s must have %q without a value", typ, LabelQuestion)
+			}
+		}
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		d, err := New(&porker.Deck{Type: porker.DeckType_DECK_TYPE_CUSTOM, Cards: []*porker.Card{
+			{Label: " small ", Value: wrapperspb.Double(1)},
+			{Label: "large", Value: wrapperspb.Double(10)},
+			{Label: "pass"},
+		}})
+		if err != nil {
+			t.Fatalf("failed to New: %v", err)
+		}
+		if c, ok := Find(d, "small"); !ok || c.GetValue().GetValue() != 1 {
+			t.Errorf("labels must be trimmed with the value kept: %v", d.Cards)
+		}
+	})
+}
+
+func TestNew_Errors(t *testing.T) {
+	custom := func(cards ...*porker.Card) *porker.Deck {
+		return &porker.Deck{Type: porker.DeckType_DECK_TYPE_CUSTOM, Cards: cards}
+	}
+	tooMany := make([]*porker.Card, MaxCards+1)
+	for i := range tooMany {
+		tooMany[i] = &porker.Card{Label: fmt.Sprintf("card%d", i)}
+	}
+
+	for name, d := range map[string]*porker.Deck{
+		"unknown type":       {Type: porker.DeckType(100)},
+		"built-in and cards": {Type: porker.DeckType_DECK_TYPE_T_SHIRT, Cards: []*porker.Card{{Label: "S"}}},
+		"no cards":           custom(),
+		"too many cards":     custom(tooMany...),
+		"empty label":        custom(&porker.Card{Label: " "}),
+		"long label":         custom(&porker.Card{Label: strings.Repeat("x", MaxLabelLength+1)}),
+		"duplicated label":   custom(&porker.Card{Label: "a"}, &porker.Card{Label: " a"}),
+		"infinite value":     custom(&porker.Card{Label: "a", Value: wrapperspb.Double(math.Inf(1))}),
+	} {
+		if _, err := New(d); !errs.IsInvalidArgumentError(err) {
+			t.Errorf("%s: expected InvalidArgumentError, actual %v", name, err)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	d, err := New(&porker.Deck{Type: porker.DeckType_DECK_TYPE_MODIFIED_FIBONACCI})
+	if err != nil {
+		t.Fatalf("failed to New: %v", err)
+	}
+
+	tests := map[string]struct {
+		point porker.Point
+		label string
+		want  porker.Point
+		card  string
+	}{
+		"point":         {point: porker.Point_POINT_3, want: porker.Point_POINT_3, card: "3"},
+		"card of point": {label: "1/2", want: porker.Point_POINT_HALF, card: "1/2"},
+		"card only":     {label: "40", want: porker.Point_POINT_CARD, card: "40"},
+		"card wins":     {point: porker.Point_POINT_1, label: "100", want: porker.Point_POINT_CARD, card: "100"},
+		"not vote":      {point: porker.Point_NOT_VOTE, label: "3", want: porker.Point_NOT_VOTE},
+		"withdraw":      {point: porker.Point_POINT_UNKNOWN, want: porker.Point_POINT_UNKNOWN},
+		"special card":  {point: porker.Point_POINT_COFFEE, want: porker.Point_POINT_COFFEE, card: LabelCoffee},
+	}
+	for name, tt := range tests {
+		point, card, err := Resolve(d, tt.point, tt.label)
+		if err != nil {
+			t.Errorf("%s: failed to Resolve: %v", name, err)
+			continue
+		}
+		if point != tt.want || card != tt.card {
+			t.Errorf("%s: expected %s %q, actual %s %q", name, tt.want, tt.card, point, card)
+		}
+	}
+
+	for name, tt := range map[string]struct {
+		point porker.Point
+		label string
+	}{
+		"point not in deck": {point: porker.Point_POINT_21},
+		"card not in deck":  {label: "21"},
+		"card point only":   {point: porker.Point_POINT_CARD},
+	} {
+		if _, _, err := Resolve(d, tt.point, tt.label); !errs.IsInvalidArgumentError(err) {
+			t.Errorf("%s: expected InvalidArgumentError, actual %v", name, err)
+		}
+	}
+}
+
+func TestOrDefault(t *testing.T) {
+	if d := OrDefault(nil); d.GetType() != porker.DeckType_DECK_TYPE_FIBONACCI {
+		t.Errorf("rooms without a deck must use the Fibonacci deck: %v", d)
+	}
+}
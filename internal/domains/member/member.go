@@ -0,0 +1,134 @@
+package member
+
+import (
+	"fmt"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+)
+
+// RoleOf returns the role of the login in the room.
+// Members of rooms created before the roles have only a ballot, and they are voters.
+func RoleOf(ps *porker.PokerSituation, loginID string) (porker.Role, bool) {
+	for _, m := range ps.GetMembers() {
+		if m.LoginId == loginID {
+			return m.Role, true
+		}
+	}
+	for _, b := range ps.GetBallots() {
+		if b.LoginId == loginID {
+			return porker.Role_ROLE_VOTER, true
+		}
+	}
+	return porker.Role_ROLE_UNKNOWN, false
+}
+
+// IsFacilitator reports whether the login can manage the room. The master of the room always can.
+func IsFacilitator(ps *porker.PokerSituation, loginID string) bool {
+	if loginID == ps.GetMasterLoginId() {
+		return true
+	}
+	role, _ := RoleOf(ps, loginID)
+	return role == porker.Role_ROLE_FACILITATOR
+}
+
+// Join adds the login to the room with the role. ROLE_UNKNOWN is ROLE_FACILITATOR for the master
+// and ROLE_VOTER for others who enter for the first time.
+// Members other than the master keep their roles whatever the role is, because only ChangeRole changes them.
+func Join(ps *porker.PokerSituation, loginID string, role porker.Role) error {
+	if err := validate(role, true); err != nil {
+		return err
+	}
+
+	current, isMember := RoleOf(ps, loginID)
+	switch {
+	case isMember && loginID != ps.MasterLoginId:
+		// 降格された member が入り直して役割を戻せないようにする
+		role = current
+	case role != porker.Role_ROLE_UNKNOWN:
+	case isMember:
+		role = current
+	case loginID == ps.MasterLoginId:
+		role = porker.Role_ROLE_FACILITATOR
+	default:
+		role = porker.Role_ROLE_VOTER
+	}
+
+	if role == porker.Role_ROLE_FACILITATOR && current != porker.Role_ROLE_FACILITATOR && loginID != ps.MasterLoginId {
+		return errs.NewPermissionDeniedError(fmt.Sprintf(
+			"only the master can enter as a facilitator. login_id: %s, room_id: %s", loginID, ps.RoomId))
+	}
+
+	set(ps, loginID, role)
+	return nil
+}
+
+// ChangeRole changes the role of the member.
+func ChangeRole(ps *porker.PokerSituation, loginID string, role porker.Role) error {
+	if err := validate(role, false); err != nil {
+		return err
+	}
+	if _, ok := RoleOf(ps, loginID); !ok {
+		return errs.NewNotFoundError(fmt.Sprintf("login_id: %s is not found in room. room_id: %s", loginID, ps.RoomId))
+	}
+
+	set(ps, loginID, role)
+	return nil
+}
+
+// Remove deletes the login and its ballot from the room.
+func Remove(ps *porker.PokerSituation, loginID string) {
+	members := make([]*porker.Member, 0, len(ps.Members))
+	for _, m := range ps.Members {
+		if m.LoginId != loginID {
+			members = append(members, m)
+		}
+	}
+	ps.Members = members
+	removeBallot(ps, loginID)
+}
+
+func validate(role porker.Role, allowUnknown bool) error {
+	if _, ok := porker.Role_name[int32(role)]; !ok || (role == porker.Role_ROLE_UNKNOWN && !allowUnknown) {
+		return errs.NewInvalidArgumentError(fmt.Sprintf("invalid role: %s", role))
+	}
+	return nil
+}
+
+// set records the role and gives a ballot to the members other than observers.
+func set(ps *porker.PokerSituation, loginID string, role porker.Role) {
+	var found bool
+	for _, m := range ps.Members {
+		if m.LoginId == loginID {
+			m.Role = role
+			found = true
+		}
+	}
+	if !found {
+		ps.Members = append(ps.Members, &porker.Member{LoginId: loginID, Role: role})
+	}
+
+	if role == porker.Role_ROLE_OBSERVER {
+		removeBallot(ps, loginID)
+		return
+	}
+	for _, b := range ps.Ballots {
+		if b.LoginId == loginID {
+			return
+		}
+	}
+	ps.Ballots = append(ps.Ballots, &porker.Ballot{
+		LoginId: loginID,
+		Point:   porker.Point_POINT_UNKNOWN,
+	})
+}
+
+func removeBallot(ps *porker.PokerSituation, loginID string) {
+	ballots := make([]*porker.Ballot, 0, len(ps.Ballots))
+	for _, b := range ps.Ballots {
+		if b.LoginId != loginID {
+			ballots = append(ballots, b)
+		}
+	}
+	ps.Ballots = ballots
+}
@@ -0,0 +1,125 @@
+package member
+
+import (
+	"testing"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+)
+
+func hasBallot(ps *porker.PokerSituation, loginID string) bool {
+	for _, b := range ps.Ballots {
+		if b.LoginId == loginID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestJoin(t *testing.T) {
+	ps := &porker.PokerSituation{RoomId: "12345", MasterLoginId: "alice"}
+
+	for _, tt := range []struct {
+		loginID string
+		role    porker.Role
+		want    porker.Role
+		ballot  bool
+	}{
+		{loginID: "alice", want: porker.Role_ROLE_FACILITATOR, ballot: true},
+		{loginID: "bob", want: porker.Role_ROLE_VOTER, ballot: true},
+		{loginID: "carol", role: porker.Role_ROLE_OBSERVER, want: porker.Role_ROLE_OBSERVER},
+		// entering again keeps the role
+		{loginID: "carol", want: porker.Role_ROLE_OBSERVER},
+		{loginID: "carol", role: porker.Role_ROLE_VOTER, want: porker.Role_ROLE_OBSERVER},
+		{loginID: "bob", role: porker.Role_ROLE_OBSERVER, want: porker.Role_ROLE_VOTER, ballot: true},
+		// the master can choose the role again
+		{loginID: "alice", role: porker.Role_ROLE_VOTER, want: porker.Role_ROLE_VOTER, ballot: true},
+	} {
+		if err := Join(ps, tt.loginID, tt.role); err != nil {
+			t.Fatalf("failed to Join: %v", err)
+		}
+		if role, ok := RoleOf(ps, tt.loginID); !ok || role != tt.want {
+			t.Errorf("%s entering as %s: expected %s, actual %s", tt.loginID, tt.role, tt.want, role)
+		}
+		if hasBallot(ps, tt.loginID) != tt.ballot {
+			t.Errorf("%s as %s: expected ballot %v", tt.loginID, tt.want, tt.ballot)
+		}
+	}
+	if len(ps.Members) != 3 || len(ps.Ballots) != 2 {
+		t.Errorf("unexpected members: %v", ps)
+	}
+	// the master is a facilitator whatever the role is
+	if !IsFacilitator(ps, "alice") || IsFacilitator(ps, "bob") {
+		t.Errorf("unexpected facilitators: %v", ps.Members)
+	}
+
+	if err := Join(ps, "dave", porker.Role_ROLE_FACILITATOR); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError, actual %v", err)
+	}
+	if err := Join(ps, "dave", porker.Role(100)); !errs.IsInvalidArgumentError(err) {
+		t.Errorf("expected InvalidArgumentError, actual %v", err)
+	}
+}
+
+func TestChangeRole(t *testing.T) {
+	ps := &porker.PokerSituation{
+		RoomId:        "12345",
+		MasterLoginId: "alice",
+		// rooms created before the roles
+		Ballots: []*porker.Ballot{{LoginId: "bob", Point: porker.Point_POINT_3}},
+	}
+
+	if err := ChangeRole(ps, "bob", porker.Role_ROLE_FACILITATOR); err != nil {
+		t.Fatalf("failed to ChangeRole: %v", err)
+	}
+	if !IsFacilitator(ps, "bob") || ps.Ballots[0].Point != porker.Point_POINT_3 {
+		t.Errorf("the ballot must be kept: %v", ps)
+	}
+	// a promoted facilitator can enter again as a facilitator
+	if err := Join(ps, "bob", porker.Role_ROLE_FACILITATOR); err != nil {
+		t.Errorf("failed to Join: %v", err)
+	}
+
+	if err := ChangeRole(ps, "bob", porker.Role_ROLE_OBSERVER); err != nil {
+		t.Fatalf("failed to ChangeRole: %v", err)
+	}
+	if hasBallot(ps, "bob") {
+		t.Error("observers must not have a ballot")
+	}
+	// a demoted member cannot take the role back by entering again
+	for _, role := range []porker.Role{porker.Role_ROLE_VOTER, porker.Role_ROLE_FACILITATOR} {
+		if err := Join(ps, "bob", role); err != nil {
+			t.Fatalf("failed to Join: %v", err)
+		}
+		if r, _ := RoleOf(ps, "bob"); r != porker.Role_ROLE_OBSERVER || hasBallot(ps, "bob") {
+			t.Errorf("entering as %s: expected the observer without a ballot, actual %s", role, r)
+		}
+	}
+	if err := ChangeRole(ps, "bob", porker.Role_ROLE_VOTER); err != nil {
+		t.Fatalf("failed to ChangeRole: %v", err)
+	}
+	if !hasBallot(ps, "bob") {
+		t.Error("voters must have a ballot")
+	}
+
+	if err := ChangeRole(ps, "carol", porker.Role_ROLE_VOTER); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, actual %v", err)
+	}
+	if err := ChangeRole(ps, "bob", porker.Role_ROLE_UNKNOWN); !errs.IsInvalidArgumentError(err) {
+		t.Errorf("expected InvalidArgumentError, actual %v", err)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	ps := &porker.PokerSituation{RoomId: "12345", MasterLoginId: "alice"}
+	for _, loginID := range []string{"alice", "bob"} {
+		if err := Join(ps, loginID, porker.Role_ROLE_UNKNOWN); err != nil {
+			t.Fatalf("failed to Join: %v", err)
+		}
+	}
+
+	Remove(ps, "bob")
+	if _, ok := RoleOf(ps, "bob"); ok || hasBallot(ps, "bob") || len(ps.Members) != 1 {
+		t.Errorf("bob must be removed: %v", ps)
+	}
+}
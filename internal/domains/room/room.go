@@ -22,6 +22,30 @@ type (
 	ID string
 )
 
+// KeyStrategy builds the keys of a room in the mem db and the stream transport.
+type KeyStrategy interface {
+	IDKey(id ID) string
+	MemberKey(id ID) string
+	StreamKey(id ID) string
+}
+
+type (
+	plainKeys      struct{}
+	hashTaggedKeys struct{}
+)
+
+// NewKeyStrategy returns the key layout of rooms.
+// With hashTagged, the keys of a room share the room id as a hash tag ("porker_room_id:{12345}")
+// so that Redis Cluster stores them in the same slot.
+// Rooms stored with the other layout can not be found after switching, so live rooms are lost in the rollout.
+// Otherwise the original keys ("porker_room_id:12345") are kept.
+func NewKeyStrategy(hashTagged bool) KeyStrategy {
+	if hashTagged {
+		return hashTaggedKeys{}
+	}
+	return plainKeys{}
+}
+
 func NewID() ID {
 	return ID(random.RandString6ByParam(5, idPattern))
 }
@@ -41,3 +65,27 @@ func (id ID) StreamKey() string {
 func (id ID) String() string {
 	return string(id)
 }
+
+func (plainKeys) IDKey(id ID) string {
+	return id.IDKey()
+}
+
+func (plainKeys) MemberKey(id ID) string {
+	return id.MemberKey()
+}
+
+func (plainKeys) StreamKey(id ID) string {
+	return id.StreamKey()
+}
+
+func (hashTaggedKeys) IDKey(id ID) string {
+	return fmt.Sprintf("%s:{%s}", idKeyPrefix, id)
+}
+
+func (hashTaggedKeys) MemberKey(id ID) string {
+	return fmt.Sprintf("%s:{%s}", memberKeyPrefix, id)
+}
+
+func (hashTaggedKeys) StreamKey(id ID) string {
+	return fmt.Sprintf("%s:{%s}", streamKeyPrefix, id)
+}
@@ -0,0 +1,22 @@
+package room
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKeyStrategy(t *testing.T) {
+	id := ID("12345")
+
+	for hashTagged, suffix := range map[bool]string{
+		false: ":12345",
+		true:  ":{12345}",
+	} {
+		keys := NewKeyStrategy(hashTagged)
+		for _, key := range []string{keys.IDKey(id), keys.MemberKey(id), keys.StreamKey(id)} {
+			if !strings.HasSuffix(key, suffix) {
+				t.Errorf("hash tagged %v: key must end with %s: %s", hashTagged, suffix, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package round
+
+import (
+	"time"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/domains/story"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// Round is an estimation round of a room whose ballots have been revealed.
+	Round struct {
+		RoomID room.ID
+		// Story is the title of the estimated story, or empty if the room does not have one.
+		Story   string
+		Ballots []*porker.Ballot
+		// StartedAt is when the voting of the round was opened, or zero if the room did not record it.
+		StartedAt  time.Time
+		RevealedAt time.Time
+	}
+)
+
+func New(ps *porker.PokerSituation, revealedAt time.Time) *Round {
+	ballots := make([]*porker.Ballot, 0, len(ps.Ballots))
+	for _, b := range ps.Ballots {
+		ballots = append(ballots, &porker.Ballot{
+			LoginId: b.LoginId,
+			Point:   b.Point,
+			Card:    b.Card,
+		})
+	}
+
+	var title string
+	if s, ok := story.Current(ps); ok {
+		title = s.Title
+	}
+
+	var startedAt time.Time
+	if ps.RoundStartedAt != nil {
+		startedAt = ps.RoundStartedAt.AsTime()
+	}
+
+	return &Round{
+		RoomID:     room.ID(ps.RoomId),
+		Story:      title,
+		Ballots:    ballots,
+		StartedAt:  startedAt,
+		RevealedAt: revealedAt,
+	}
+}
+
+func (rd *Round) ToProto() *porker.ArchivedRound {
+	ar := &porker.ArchivedRound{
+		RoomId:     rd.RoomID.String(),
+		Story:      rd.Story,
+		Ballots:    rd.Ballots,
+		RevealedAt: timestamppb.New(rd.RevealedAt),
+	}
+	if !rd.StartedAt.IsZero() {
+		ar.StartedAt = timestamppb.New(rd.StartedAt)
+	}
+	return ar
+}
+
+// HasBallot reports whether the login took part in the round.
+func (rd *Round) HasBallot(loginID string) bool {
+	for _, b := range rd.Ballots {
+		if b.LoginId == loginID {
+			return true
+		}
+	}
+	return false
+}
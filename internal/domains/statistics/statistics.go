@@ -0,0 +1,108 @@
+package statistics
+
+import (
+	"sort"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/domains/deck"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type (
+	vote struct {
+		loginID string
+		card    *porker.Card
+	}
+)
+
+// Summarize computes the summary of the revealed ballots, so that every client shows the same numbers.
+func Summarize(d *porker.Deck, ballots []*porker.Ballot) *porker.VoteSummary {
+	summary := &porker.VoteSummary{}
+
+	var votes []vote
+	for _, b := range ballots {
+		if b.Point == porker.Point_NOT_VOTE {
+			summary.AbstainedCount++
+			continue
+		}
+		c, ok := deck.CardOf(d, b)
+		if !ok {
+			summary.NotVotedCount++
+			continue
+		}
+		votes = append(votes, vote{loginID: b.LoginId, card: c})
+	}
+	summary.VotedCount = int32(len(votes))
+
+	summary.Mode, summary.Consensus = mode(d, votes)
+
+	var values []float64
+	for _, v := range votes {
+		if v.card.Value != nil {
+			values = append(values, v.card.Value.Value)
+		}
+	}
+	if len(values) == 0 {
+		return summary
+	}
+	sort.Float64s(values)
+
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	summary.Average = wrapperspb.Double(sum / float64(len(values)))
+	summary.Median = wrapperspb.Double(median(values))
+	summary.Min = holders(votes, values[0])
+	summary.Max = holders(votes, values[len(values)-1])
+	summary.Spread = wrapperspb.Double(values[len(values)-1] - values[0])
+
+	return summary
+}
+
+// mode returns the most voted cards in the order of the deck, and whether all votes are the same card.
+func mode(d *porker.Deck, votes []vote) ([]string, bool) {
+	counts := map[string]int{}
+	var most int
+	for _, v := range votes {
+		counts[v.card.Label]++
+		if counts[v.card.Label] > most {
+			most = counts[v.card.Label]
+		}
+	}
+	if most == 0 {
+		return nil, false
+	}
+
+	var labels []string
+	for _, c := range d.GetCards() {
+		if counts[c.Label] == most {
+			labels = append(labels, c.Label)
+		}
+	}
+	return labels, len(counts) == 1
+}
+
+// median returns the median of the sorted values.
+func median(values []float64) float64 {
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
+
+// holders returns the logins that voted the value. The card is the first one voted if several cards have the value.
+func holders(votes []vote, value float64) *porker.CardHolders {
+	h := &porker.CardHolders{Value: value}
+	for _, v := range votes {
+		if v.card.Value == nil || v.card.Value.Value != value {
+			continue
+		}
+		if h.Card == "" {
+			h.Card = v.card.Label
+		}
+		h.LoginIds = append(h.LoginIds, v.loginID)
+	}
+	return h
+}
@@ -0,0 +1,110 @@
+package statistics
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/domains/deck"
+)
+
+func newDeck(t *testing.T, typ porker.DeckType) *porker.Deck {
+	d, err := deck.New(&porker.Deck{Type: typ})
+	if err != nil {
+		t.Fatalf("failed to deck.New: %v", err)
+	}
+	return d
+}
+
+func ballot(loginID, card string) *porker.Ballot {
+	return &porker.Ballot{LoginId: loginID, Point: porker.Point_POINT_CARD, Card: card}
+}
+
+func TestSummarize(t *testing.T) {
+	d := newDeck(t, porker.DeckType_DECK_TYPE_FIBONACCI)
+
+	summary := Summarize(d, []*porker.Ballot{
+		ballot("a", "3"),
+		ballot("b", "8"),
+		ballot("c", "3"),
+		ballot("d", "1"),
+		ballot("e", "?"),
+		ballot("f", "8"),
+		{LoginId: "g", Point: porker.Point_NOT_VOTE},
+		{LoginId: "h", Point: porker.Point_POINT_UNKNOWN},
+		// voted before the rooms had decks
+		{LoginId: "i", Point: porker.Point_POINT_1},
+	})
+
+	if summary.VotedCount != 7 || summary.AbstainedCount != 1 || summary.NotVotedCount != 1 {
+		t.Errorf("unexpected counts: %v", summary)
+	}
+	// 1, 1, 3, 3, 8, 8
+	if got := summary.GetAverage().GetValue(); got != 4 {
+		t.Errorf("expected average 4, actual %v", got)
+	}
+	if got := summary.GetMedian().GetValue(); got != 3 {
+		t.Errorf("expected median 3, actual %v", got)
+	}
+	if got := summary.GetSpread().GetValue(); got != 7 {
+		t.Errorf("expected spread 7, actual %v", got)
+	}
+	if min := summary.Min; min.Card != "1" || min.Value != 1 || !reflect.DeepEqual(min.LoginIds, []string{"d", "i"}) {
+		t.Errorf("unexpected min: %v", min)
+	}
+	if max := summary.Max; max.Card != "8" || max.Value != 8 || !reflect.DeepEqual(max.LoginIds, []string{"b", "f"}) {
+		t.Errorf("unexpected max: %v", max)
+	}
+	if !reflect.DeepEqual(summary.Mode, []string{"1", "3", "8"}) {
+		t.Errorf("ties must be in the order of the deck: %v", summary.Mode)
+	}
+	if summary.Consensus {
+		t.Error("consensus must be false")
+	}
+}
+
+func TestSummarize_Median(t *testing.T) {
+	d := newDeck(t, porker.DeckType_DECK_TYPE_POWERS_OF_TWO)
+
+	summary := Summarize(d, []*porker.Ballot{ballot("a", "1"), ballot("b", "4"), ballot("c", "16")})
+	if got := summary.GetMedian().GetValue(); got != 4 {
+		t.Errorf("expected median 4 of odd values, actual %v", got)
+	}
+	if !reflect.DeepEqual(summary.Mode, []string{"1", "4", "16"}) {
+		t.Errorf("unexpected mode: %v", summary.Mode)
+	}
+}
+
+func TestSummarize_Consensus(t *testing.T) {
+	d := newDeck(t, porker.DeckType_DECK_TYPE_T_SHIRT)
+
+	summary := Summarize(d, []*porker.Ballot{
+		ballot("a", "M"),
+		ballot("b", "M"),
+		{LoginId: "c", Point: porker.Point_NOT_VOTE},
+	})
+	if !summary.Consensus || !reflect.DeepEqual(summary.Mode, []string{"M"}) {
+		t.Errorf("abstentions must not break the consensus: %v", summary)
+	}
+	if summary.GetSpread().GetValue() != 0 || summary.GetAverage().GetValue() != 3 {
+		t.Errorf("unexpected values: %v", summary)
+	}
+}
+
+func TestSummarize_NoValues(t *testing.T) {
+	d := newDeck(t, porker.DeckType_DECK_TYPE_FIBONACCI)
+
+	for name, ballots := range map[string][]*porker.Ballot{
+		"no ballots":    nil,
+		"only specials": {ballot("a", "?"), ballot("b", "coffee")},
+		"only not vote": {{LoginId: "a", Point: porker.Point_NOT_VOTE}},
+	} {
+		summary := Summarize(d, ballots)
+		if summary.Average != nil || summary.Median != nil || summary.Min != nil || summary.Max != nil || summary.Spread != nil {
+			t.Errorf("%s: values must not be set: %v", name, summary)
+		}
+		if summary.Consensus {
+			t.Errorf("%s: consensus must be false", name)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package story
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/random"
+)
+
+const (
+	MaxStories           = 100
+	MaxTitleLength       = 200
+	MaxLinkLength        = 2048
+	MaxDescriptionLength = 2000
+
+	idLength = 8
+)
+
+// New returns a story with a new id. The estimate is not taken over from the given story.
+func New(s *porker.Story) (*porker.Story, error) {
+	title := strings.TrimSpace(s.GetTitle())
+	if title == "" || utf8.RuneCountInString(title) > MaxTitleLength {
+		return nil, errs.NewInvalidArgumentError(fmt.Sprintf("a story title must have 1 to %d characters", MaxTitleLength))
+	}
+
+	link := strings.TrimSpace(s.GetLink())
+	if link != "" {
+		u, err := url.Parse(link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(link) > MaxLinkLength {
+			return nil, errs.NewInvalidArgumentError(fmt.Sprintf("a story link must be a http(s) url up to %d bytes: %q", MaxLinkLength, link))
+		}
+	}
+
+	if utf8.RuneCountInString(s.GetDescription()) > MaxDescriptionLength {
+		return nil, errs.NewInvalidArgumentError(fmt.Sprintf("a story description must have %d characters or less", MaxDescriptionLength))
+	}
+
+	return &porker.Story{
+		StoryId:     random.RandString6(idLength),
+		Title:       title,
+		Link:        link,
+		Description: s.GetDescription(),
+	}, nil
+}
+
+// Add appends the story to the room. It becomes the current story if the room does not have one.
+func Add(ps *porker.PokerSituation, s *porker.Story) error {
+	if len(ps.Stories) >= MaxStories {
+		return errs.NewInvalidStateError(fmt.Sprintf("a room can have %d stories at most. room_id: %s", MaxStories, ps.RoomId))
+	}
+
+	ps.Stories = append(ps.Stories, s)
+	if ps.CurrentStoryId == "" && s.Estimate == "" {
+		ps.CurrentStoryId = s.StoryId
+	}
+	return nil
+}
+
+// Move changes the position of the story to the index.
+func Move(ps *porker.PokerSituation, storyID string, index int) error {
+	i, err := find(ps, storyID)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(ps.Stories) {
+		return errs.NewInvalidArgumentError(fmt.Sprintf("index must be 0 to %d: %d", len(ps.Stories)-1, index))
+	}
+
+	s := ps.Stories[i]
+	stories := append(ps.Stories[:i:i], ps.Stories[i+1:]...)
+	ps.Stories = append(stories[:index:index], append([]*porker.Story{s}, stories[index:]...)...)
+	return nil
+}
+
+// Remove deletes the story. If it is the current story, the next story to estimate becomes the current one.
+func Remove(ps *porker.PokerSituation, storyID string) error {
+	i, err := find(ps, storyID)
+	if err != nil {
+		return err
+	}
+
+	if ps.CurrentStoryId == storyID {
+		ps.CurrentStoryId = next(ps, i)
+	}
+	ps.Stories = append(ps.Stories[:i:i], ps.Stories[i+1:]...)
+	return nil
+}
+
+// Current returns the story being estimated.
+func Current(ps *porker.PokerSituation) (*porker.Story, bool) {
+	for _, s := range ps.GetStories() {
+		if s.StoryId == ps.GetCurrentStoryId() {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// Advance records the estimate on the current story and moves to the next story without an estimate.
+// Without an estimate the current story stays, so that it is voted again.
+// It returns errs.InvalidStateError if the estimate is given while the room has no current story.
+func Advance(ps *porker.PokerSituation, estimate string) error {
+	if estimate == "" {
+		return nil
+	}
+	current, ok := Current(ps)
+	if !ok {
+		return errs.NewInvalidStateError(fmt.Sprintf("there is no story to record the estimate. room_id: %s", ps.GetRoomId()))
+	}
+	current.Estimate = estimate
+
+	i, _ := find(ps, current.StoryId)
+	ps.CurrentStoryId = next(ps, i)
+	return nil
+}
+
+// next returns the id of the first story without an estimate after the index, wrapping around to the start of the list.
+// It returns empty if every other story has an estimate.
+func next(ps *porker.PokerSituation, index int) string {
+	for n := 1; n < len(ps.Stories); n++ {
+		if s := ps.Stories[(index+n)%len(ps.Stories)]; s.Estimate == "" {
+			return s.StoryId
+		}
+	}
+	return ""
+}
+
+func find(ps *porker.PokerSituation, storyID string) (int, error) {
+	for i, s := range ps.GetStories() {
+		if s.StoryId == storyID {
+			return i, nil
+		}
+	}
+	return 0, errs.NewNotFoundError(fmt.Sprintf("story does not exist. room_id: %s, story_id: %s", ps.GetRoomId(), storyID))
+}
@@ -0,0 +1,198 @@
+package story
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+)
+
+func newSituation(t *testing.T, titles ...string) *porker.PokerSituation {
+	ps := &porker.PokerSituation{RoomId: "12345"}
+	for _, title := range titles {
+		s, err := New(&porker.Story{Title: title})
+		if err != nil {
+			t.Fatalf("failed to New: %v", err)
+		}
+		if err := Add(ps, s); err != nil {
+			t.Fatalf("failed to Add: %v", err)
+		}
+	}
+	return ps
+}
+
+func titles(ps *porker.PokerSituation) string {
+	var ts []string
+	for _, s := range ps.Stories {
+		ts = append(ts, s.Title)
+	}
+	return strings.Join(ts, ",")
+}
+
+func id(ps *porker.PokerSituation, title string) string {
+	for _, s := range ps.Stories {
+		if s.Title == title {
+			return s.StoryId
+		}
+	}
+	return ""
+}
+
+func TestNew(t *testing.T) {
+	s, err := New(&porker.Story{StoryId: "given", Title: " login ", Link: "https://example.com/issues/1", Estimate: "3"})
+	if err != nil {
+		t.Fatalf("failed to New: %v", err)
+	}
+	if s.StoryId == "" || s.StoryId == "given" || s.Title != "login" || s.Estimate != "" {
+		t.Errorf("unexpected story: %v", s)
+	}
+
+	for name, s := range map[string]*porker.Story{
+		"empty title":      {Title: " "},
+		"long title":       {Title: strings.Repeat("x", MaxTitleLength+1)},
+		"not http link":    {Title: "a", Link: "javascript:alert(1)"},
+		"relative link":    {Title: "a", Link: "/issues/1"},
+		"long description": {Title: "a", Description: strings.Repeat("x", MaxDescriptionLength+1)},
+	} {
+		if _, err := New(s); !errs.IsInvalidArgumentError(err) {
+			t.Errorf("%s: expected InvalidArgumentError, actual %v", name, err)
+		}
+	}
+}
+
+func TestAdd(t *testing.T) {
+	ps := newSituation(t, "a", "b")
+	if ps.CurrentStoryId != id(ps, "a") {
+		t.Errorf("the first story must be the current one: %v", ps)
+	}
+
+	ps.Stories = make([]*porker.Story, MaxStories)
+	if err := Add(ps, &porker.Story{StoryId: "x"}); !errs.IsInvalidStateError(err) {
+		t.Errorf("expected InvalidStateError, actual %v", err)
+	}
+}
+
+func TestMove(t *testing.T) {
+	ps := newSituation(t, "a", "b", "c", "d")
+
+	for _, tt := range []struct {
+		title    string
+		index    int
+		expected string
+	}{
+		{title: "a", index: 3, expected: "b,c,d,a"},
+		{title: "d", index: 0, expected: "d,b,c,a"},
+		{title: "c", index: 1, expected: "d,c,b,a"},
+		{title: "c", index: 1, expected: "d,c,b,a"},
+	} {
+		if err := Move(ps, id(ps, tt.title), tt.index); err != nil {
+			t.Fatalf("failed to Move: %v", err)
+		}
+		if actual := titles(ps); actual != tt.expected {
+			t.Errorf("move %s to %d: expected %s, actual %s", tt.title, tt.index, tt.expected, actual)
+		}
+	}
+
+	if err := Move(ps, id(ps, "a"), 4); !errs.IsInvalidArgumentError(err) {
+		t.Errorf("expected InvalidArgumentError, actual %v", err)
+	}
+	if err := Move(ps, "unknown", 0); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, actual %v", err)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	ps := newSituation(t, "a", "b", "c")
+
+	if err := Remove(ps, id(ps, "a")); err != nil {
+		t.Fatalf("failed to Remove: %v", err)
+	}
+	if titles(ps) != "b,c" || ps.CurrentStoryId != id(ps, "b") {
+		t.Errorf("the next story must be the current one: %v", ps)
+	}
+	if err := Remove(ps, id(ps, "c")); err != nil {
+		t.Fatalf("failed to Remove: %v", err)
+	}
+	if titles(ps) != "b" || ps.CurrentStoryId != id(ps, "b") {
+		t.Errorf("the current story must not change: %v", ps)
+	}
+	if err := Remove(ps, "unknown"); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, actual %v", err)
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	ps := newSituation(t, "a", "b", "c")
+	ps.Stories[1].Estimate = "5"
+
+	if err := Advance(ps, "3"); err != nil {
+		t.Fatalf("failed to Advance: %v", err)
+	}
+	if ps.Stories[0].Estimate != "3" || ps.CurrentStoryId != id(ps, "c") {
+		t.Errorf("estimated stories must be skipped: %v", ps)
+	}
+
+	// voted again without an estimate
+	if err := Advance(ps, ""); err != nil {
+		t.Fatalf("failed to Advance: %v", err)
+	}
+	if ps.Stories[2].Estimate != "" || ps.CurrentStoryId != id(ps, "c") {
+		t.Errorf("the current story must stay for the re-vote: %v", ps)
+	}
+
+	if err := Advance(ps, "8"); err != nil {
+		t.Fatalf("failed to Advance: %v", err)
+	}
+	if ps.Stories[2].Estimate != "8" || ps.CurrentStoryId != "" {
+		t.Errorf("the agenda must be finished: %v", ps)
+	}
+	if _, ok := Current(ps); ok {
+		t.Error("there must be no current story")
+	}
+
+	// the estimate cannot be recorded without the current story
+	if err := Advance(ps, "13"); !errs.IsInvalidStateError(err) {
+		t.Errorf("expected InvalidStateError, actual %v", err)
+	}
+	if err := Advance(ps, ""); err != nil {
+		t.Errorf("a reset without an estimate must be allowed, actual %v", err)
+	}
+
+	// added stories are estimated next
+	s, err := New(&porker.Story{Title: "d"})
+	if err != nil {
+		t.Fatalf("failed to New: %v", err)
+	}
+	if err := Add(ps, s); err != nil {
+		t.Fatalf("failed to Add: %v", err)
+	}
+	if ps.CurrentStoryId != s.StoryId {
+		t.Errorf("the added story must be the current one: %v", ps)
+	}
+}
+
+func TestAdvance_WrapAround(t *testing.T) {
+	ps := newSituation(t, "a", "b", "c")
+	// "a" was moved before the current story without being estimated
+	ps.CurrentStoryId = id(ps, "b")
+
+	if err := Advance(ps, "3"); err != nil {
+		t.Fatalf("failed to Advance: %v", err)
+	}
+	if ps.CurrentStoryId != id(ps, "c") {
+		t.Errorf("the story after the current one must come first: %v", ps)
+	}
+	if err := Advance(ps, "5"); err != nil {
+		t.Fatalf("failed to Advance: %v", err)
+	}
+	if ps.CurrentStoryId != id(ps, "a") {
+		t.Errorf("the unestimated story before the current one must come next: %v", ps)
+	}
+	if err := Advance(ps, "8"); err != nil {
+		t.Fatalf("failed to Advance: %v", err)
+	}
+	if ps.CurrentStoryId != "" {
+		t.Errorf("the agenda must be finished: %v", ps)
+	}
+}
@@ -0,0 +1,586 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/memdb"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+)
+
+const (
+	subscribeDuration = 3 * time.Second
+	sweepInterval     = time.Minute
+	openTimeout       = 3 * time.Second
+)
+
+var (
+	bucketValues  = []byte("values")
+	bucketSets    = []byte("sets")
+	bucketStreams = []byte("streams")
+	bucketExpires = []byte("expires")
+	bucketMeta    = []byte("meta")
+
+	keyLastStreamID = []byte("last_stream_id")
+)
+
+type (
+	// boltClient is a MemDBClient that keeps all data in a bbolt file, so it survives a restart of the process.
+	// Values are stored in "values", each set and stream is a nested bucket of "sets" and "streams",
+	// and the expiration time of keys is stored in "expires" as unix nanoseconds.
+	boltClient struct {
+		db        *bbolt.DB
+		now       func() time.Time
+		retention gateways.StreamRetention
+		// nextSweep is accessed only in write transactions, which bbolt runs one at a time.
+		nextSweep time.Time
+
+		mu     sync.Mutex
+		notify chan struct{}
+	}
+)
+
+func NewBoltClient(config Config, retention gateways.StreamRetention) (gateways.MemDBClient, error) {
+	return newBoltClient(config, time.Now, retention)
+}
+
+func newBoltClient(config Config, now func() time.Time, retention gateways.StreamRetention) (*boltClient, error) {
+	db, err := bbolt.Open(config.Path, 0600, &bbolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, xerrors.Errorf("failed to open bolt: %w", err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range [][]byte{bucketValues, bucketSets, bucketStreams, bucketExpires, bucketMeta} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return xerrors.Errorf("failed to create bucket %s: %w", name, err)
+			}
+		}
+		return nil
+	}); err != nil {
+		_ = db.Close()
+		return nil, xerrors.Errorf("failed to initialize bolt: %w", err)
+	}
+
+	return &boltClient{
+		db:        db,
+		now:       now,
+		retention: retention,
+		notify:    make(chan struct{}),
+	}, nil
+}
+
+func (c *boltClient) Ping(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return xerrors.Errorf("failed to bolt Ping: %w", err)
+	}
+	if err := c.db.View(func(*bbolt.Tx) error { return nil }); err != nil {
+		return xerrors.Errorf("failed to bolt Ping: %w", err)
+	}
+	return nil
+}
+
+func (c *boltClient) Close() error {
+	if err := c.db.Close(); err != nil {
+		return xerrors.Errorf("failed to bolt Close: %w", err)
+	}
+	return nil
+}
+
+func (c *boltClient) Set(_ context.Context, key string, value interface{}, duration time.Duration) error {
+	v, err := memdb.Stringify(value)
+	if err != nil {
+		return xerrors.Errorf("failed to bolt Set: %w", err)
+	}
+
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		if err := c.sweep(tx); err != nil {
+			return err
+		}
+		if err := c.delete(tx, key); err != nil {
+			return err
+		}
+		if err := tx.Bucket(bucketValues).Put([]byte(key), []byte(v)); err != nil {
+			return err
+		}
+		return c.setExpire(tx, key, duration)
+	}); err != nil {
+		return xerrors.Errorf("failed to bolt Set: %w", err)
+	}
+	return nil
+}
+
+func (c *boltClient) SetNX(_ context.Context, key string, value interface{}, duration time.Duration) error {
+	v, err := memdb.Stringify(value)
+	if err != nil {
+		return xerrors.Errorf("failed to bolt SetNX: %w", err)
+	}
+
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		if err := c.sweep(tx); err != nil {
+			return err
+		}
+		if err := c.expireIfNeeded(tx, key); err != nil {
+			return err
+		}
+		if exists(tx, key) {
+			return nil
+		}
+		if err := tx.Bucket(bucketValues).Put([]byte(key), []byte(v)); err != nil {
+			return err
+		}
+		return c.setExpire(tx, key, duration)
+	}); err != nil {
+		return xerrors.Errorf("failed to bolt SetNX: %w", err)
+	}
+	return nil
+}
+
+func (c *boltClient) Get(_ context.Context, key string) (string, error) {
+	var (
+		val string
+		ok  bool
+	)
+	if err := c.db.View(func(tx *bbolt.Tx) error {
+		if c.expired(tx, key) {
+			return nil
+		}
+		val, ok = get(tx.Bucket(bucketValues), []byte(key))
+		return nil
+	}); err != nil {
+		return "", xerrors.Errorf("failed to bolt Get: %w", err)
+	}
+
+	if !ok {
+		return "", errs.NewNotFoundError(fmt.Sprintf("%s does not exist", key))
+	}
+	return val, nil
+}
+
+func (c *boltClient) Del(_ context.Context, keys ...string) error {
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		for _, key := range keys {
+			if err := c.delete(tx, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return xerrors.Errorf("failed to bolt Del: %w", err)
+	}
+	return nil
+}
+
+func (c *boltClient) SAdd(_ context.Context, key string, values ...interface{}) error {
+	members, err := stringifyAll(values)
+	if err != nil {
+		return xerrors.Errorf("failed to bolt SAdd: %w", err)
+	}
+
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		if err := c.sweep(tx); err != nil {
+			return err
+		}
+		if err := c.expireIfNeeded(tx, key); err != nil {
+			return err
+		}
+		set, err := tx.Bucket(bucketSets).CreateBucketIfNotExists([]byte(key))
+		if err != nil {
+			return err
+		}
+		for _, m := range members {
+			if err := set.Put([]byte(m), []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return xerrors.Errorf("failed to bolt SAdd: %w", err)
+	}
+	return nil
+}
+
+func (c *boltClient) SRem(_ context.Context, key string, members ...interface{}) error {
+	ms, err := stringifyAll(members)
+	if err != nil {
+		return xerrors.Errorf("failed to bolt SRem: %w", err)
+	}
+
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		if err := c.expireIfNeeded(tx, key); err != nil {
+			return err
+		}
+		set := tx.Bucket(bucketSets).Bucket([]byte(key))
+		if set == nil {
+			return nil
+		}
+		for _, m := range ms {
+			if err := set.Delete([]byte(m)); err != nil {
+				return err
+			}
+		}
+		if k, _ := set.Cursor().First(); k == nil {
+			return c.delete(tx, key)
+		}
+		return nil
+	}); err != nil {
+		return xerrors.Errorf("failed to bolt SRem: %w", err)
+	}
+	return nil
+}
+
+func (c *boltClient) SMembers(_ context.Context, key string) ([]string, error) {
+	members := []string{}
+	if err := c.db.View(func(tx *bbolt.Tx) error {
+		if c.expired(tx, key) {
+			return nil
+		}
+		set := tx.Bucket(bucketSets).Bucket([]byte(key))
+		if set == nil {
+			return nil
+		}
+		return set.ForEach(func(k, _ []byte) error {
+			members = append(members, string(k))
+			return nil
+		})
+	}); err != nil {
+		return nil, xerrors.Errorf("failed to bolt SMembers: %w", err)
+	}
+	return members, nil
+}
+
+func (c *boltClient) PublishStream(_ context.Context, streamKey string, messages map[string]interface{}) error {
+	values, err := memdb.EncodeMessages(messages)
+	if err != nil {
+		return xerrors.Errorf("failed to bolt PublishStream: %w", err)
+	}
+
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		return c.publish(tx, streamKey, values)
+	}); err != nil {
+		return xerrors.Errorf("failed to bolt PublishStream: %w", err)
+	}
+	c.broadcast()
+	return nil
+}
+
+func (c *boltClient) PublishStreamIfLatest(_ context.Context, streamKey, latestID string, messages map[string]interface{}) error {
+	values, err := memdb.EncodeMessages(messages)
+	if err != nil {
+		return xerrors.Errorf("failed to bolt PublishStreamIfLatest: %w", err)
+	}
+
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		if err := c.expireIfNeeded(tx, streamKey); err != nil {
+			return err
+		}
+		var currentID string
+		if stream := tx.Bucket(bucketStreams).Bucket([]byte(streamKey)); stream != nil {
+			if k, _ := stream.Cursor().Last(); k != nil {
+				currentID = memdb.StreamIDFromBytes(k).String()
+			}
+		}
+		if currentID != latestID {
+			return errs.NewConflictError(fmt.Sprintf("stream has been updated. streamKey: %s, latestID: %s", streamKey, latestID))
+		}
+
+		return c.publish(tx, streamKey, values)
+	}); err != nil {
+		if errs.IsConflictError(err) {
+			return err
+		}
+		return xerrors.Errorf("failed to bolt PublishStreamIfLatest: %w", err)
+	}
+	c.broadcast()
+	return nil
+}
+
+func (c *boltClient) publish(tx *bbolt.Tx, streamKey string, values []byte) error {
+	if err := c.sweep(tx); err != nil {
+		return err
+	}
+	if err := c.expireIfNeeded(tx, streamKey); err != nil {
+		return err
+	}
+
+	meta := tx.Bucket(bucketMeta)
+	var lastID memdb.StreamID
+	if b := meta.Get(keyLastStreamID); b != nil {
+		lastID = memdb.StreamIDFromBytes(b)
+	}
+	now := c.now()
+	id := lastID.Next(now)
+	if err := meta.Put(keyLastStreamID, id.Bytes()); err != nil {
+		return err
+	}
+
+	stream, err := tx.Bucket(bucketStreams).CreateBucketIfNotExists([]byte(streamKey))
+	if err != nil {
+		return err
+	}
+	if err := stream.Put(id.Bytes(), values); err != nil {
+		return err
+	}
+	return c.trim(stream, now)
+}
+
+// trim drops old messages of the stream according to the retention. The latest message is always kept.
+func (c *boltClient) trim(stream *bbolt.Bucket, now time.Time) error {
+	var ids [][]byte
+	cur := stream.Cursor()
+	for k, _ := cur.First(); k != nil; k, _ = cur.Next() {
+		ids = append(ids, append([]byte(nil), k...))
+	}
+
+	drop := 0
+	if maxLen := int(c.retention.MaxLen); maxLen > 0 && len(ids) > maxLen {
+		drop = len(ids) - maxLen
+	}
+	if c.retention.MaxAge > 0 {
+		minID := memdb.MinStreamID(now.Add(-c.retention.MaxAge)).Bytes()
+		for drop < len(ids)-1 && bytes.Compare(ids[drop], minID) < 0 {
+			drop++
+		}
+	}
+
+	for _, id := range ids[:drop] {
+		if err := stream.Delete(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// broadcast wakes up blocking readers after a message has been committed.
+func (c *boltClient) broadcast() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	close(c.notify)
+	c.notify = make(chan struct{})
+}
+
+func (c *boltClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error) {
+	prev, err := memdb.ParseStreamID(previousID)
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to bolt ReadStream. err: %w, streamKey: %s, messageID: %s", err, streamKey, previousID)
+	}
+
+	timer := time.NewTimer(subscribeDuration)
+	defer timer.Stop()
+
+	for {
+		// notify is taken before reading, so a message committed after the read always wakes us up.
+		c.mu.Lock()
+		notify := c.notify
+		c.mu.Unlock()
+
+		msgID, values, err := c.latest(streamKey)
+		if err != nil {
+			return "", "", xerrors.Errorf("failed to bolt ReadStream. err: %w, streamKey: %s, messageID: %s", err, streamKey, previousID)
+		}
+		if values != nil && prev.Less(msgID) {
+			v, ok := values[messageKey]
+			if !ok {
+				loggers.Logger(ctx).Warn("message key does not exist in stream message", zap.Reflect("message", values))
+				return "", "", nil
+			}
+			return msgID.String(), v, nil
+		}
+
+		select {
+		case <-notify:
+		case <-timer.C:
+			return "", "", errs.NewNotFoundError("response nil from stream")
+		case <-ctx.Done():
+			return "", "", xerrors.Errorf("failed to bolt ReadStream. err: %w, streamKey: %s, messageID: %s", ctx.Err(), streamKey, previousID)
+		}
+	}
+}
+
+func (c *boltClient) ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (id, message string, err error) {
+	msgID, values, err := c.latest(streamKey)
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to bolt ReadStreamLatest: %w", err)
+	}
+	if values == nil {
+		return "", "", errs.NewNotFoundError(fmt.Sprintf("%s does not exist", streamKey))
+	}
+
+	v, ok := values[messageKey]
+	if !ok {
+		loggers.Logger(ctx).Warn("message key does not exist in stream message", zap.Reflect("message", values))
+		return "", "", nil
+	}
+	return msgID.String(), v, nil
+}
+
+// latest returns the last message of the stream. values is nil if the stream has no message.
+func (c *boltClient) latest(streamKey string) (id memdb.StreamID, values map[string]string, err error) {
+	err = c.db.View(func(tx *bbolt.Tx) error {
+		if c.expired(tx, streamKey) {
+			return nil
+		}
+		stream := tx.Bucket(bucketStreams).Bucket([]byte(streamKey))
+		if stream == nil {
+			return nil
+		}
+		k, v := stream.Cursor().Last()
+		if k == nil {
+			return nil
+		}
+		id = memdb.StreamIDFromBytes(k)
+		values, err = memdb.DecodeMessages(v)
+		return err
+	})
+	return id, values, err
+}
+
+func (c *boltClient) ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]gateways.StreamMessage, error) {
+	result := []gateways.StreamMessage{}
+	if err := c.db.View(func(tx *bbolt.Tx) error {
+		if c.expired(tx, streamKey) {
+			return nil
+		}
+		stream := tx.Bucket(bucketStreams).Bucket([]byte(streamKey))
+		if stream == nil {
+			return nil
+		}
+		return stream.ForEach(func(k, b []byte) error {
+			values, err := memdb.DecodeMessages(b)
+			if err != nil {
+				return err
+			}
+			v, ok := values[messageKey]
+			if !ok {
+				loggers.Logger(ctx).Warn("message key does not exist in stream message", zap.Reflect("message", values))
+				return nil
+			}
+			id := memdb.StreamIDFromBytes(k)
+			result = append(result, gateways.StreamMessage{
+				ID:          id.String(),
+				Message:     v,
+				PublishedAt: id.Time(),
+			})
+			return nil
+		})
+	}); err != nil {
+		return nil, xerrors.Errorf("failed to bolt ReadStreamRange: %w", err)
+	}
+	return result, nil
+}
+
+func (c *boltClient) Expire(_ context.Context, key string, duration time.Duration) error {
+	if err := c.db.Update(func(tx *bbolt.Tx) error {
+		if err := c.expireIfNeeded(tx, key); err != nil {
+			return err
+		}
+		if !exists(tx, key) {
+			return nil
+		}
+		return c.setExpire(tx, key, duration)
+	}); err != nil {
+		return xerrors.Errorf("failed to bolt Expire: %w", err)
+	}
+	return nil
+}
+
+func exists(tx *bbolt.Tx, key string) bool {
+	k := []byte(key)
+	if _, ok := get(tx.Bucket(bucketValues), k); ok {
+		return true
+	}
+	return tx.Bucket(bucketSets).Bucket(k) != nil || tx.Bucket(bucketStreams).Bucket(k) != nil
+}
+
+// get distinguishes an empty value from a missing key, which bbolt.Bucket.Get does not.
+func get(b *bbolt.Bucket, key []byte) (string, bool) {
+	k, v := b.Cursor().Seek(key)
+	if k == nil || !bytes.Equal(k, key) || v == nil {
+		return "", false
+	}
+	return string(v), true
+}
+
+func (c *boltClient) delete(tx *bbolt.Tx, key string) error {
+	k := []byte(key)
+	if err := tx.Bucket(bucketValues).Delete(k); err != nil {
+		return err
+	}
+	for _, name := range [][]byte{bucketSets, bucketStreams} {
+		if err := tx.Bucket(name).DeleteBucket(k); err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+	}
+	return tx.Bucket(bucketExpires).Delete(k)
+}
+
+func (c *boltClient) setExpire(tx *bbolt.Tx, key string, duration time.Duration) error {
+	expires := tx.Bucket(bucketExpires)
+	if duration <= 0 {
+		return expires.Delete([]byte(key))
+	}
+	at := make([]byte, 8)
+	binary.BigEndian.PutUint64(at, uint64(c.now().Add(duration).UnixNano()))
+	return expires.Put([]byte(key), at)
+}
+
+// expired reports whether the key has expired. Read transactions treat such keys as missing
+// and leave the deletion to the next write.
+func (c *boltClient) expired(tx *bbolt.Tx, key string) bool {
+	at := tx.Bucket(bucketExpires).Get([]byte(key))
+	return at != nil && c.now().UnixNano() >= int64(binary.BigEndian.Uint64(at))
+}
+
+func (c *boltClient) expireIfNeeded(tx *bbolt.Tx, key string) error {
+	if c.expired(tx, key) {
+		return c.delete(tx, key)
+	}
+	return nil
+}
+
+// sweep drops expired keys that are never accessed again, such as abandoned rooms.
+func (c *boltClient) sweep(tx *bbolt.Tx) error {
+	now := c.now()
+	if now.Before(c.nextSweep) {
+		return nil
+	}
+	c.nextSweep = now.Add(sweepInterval)
+
+	var keys []string
+	if err := tx.Bucket(bucketExpires).ForEach(func(k, _ []byte) error {
+		if c.expired(tx, string(k)) {
+			keys = append(keys, string(k))
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := c.delete(tx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func stringifyAll(values []interface{}) ([]string, error) {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		v, err := memdb.Stringify(value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
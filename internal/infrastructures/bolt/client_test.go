@@ -0,0 +1,254 @@
+package bolt
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func openClient(t *testing.T, path string, now func() time.Time, retention gateways.StreamRetention) *boltClient {
+	cli, err := newBoltClient(Config{Path: path}, now, retention)
+	if err != nil {
+		t.Fatalf("failed to newBoltClient: %v", err)
+	}
+	t.Cleanup(func() { _ = cli.Close() })
+	return cli
+}
+
+func TestBoltClient_KeyTTL(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(1620000000, 0)}
+	cli := openClient(t, filepath.Join(t.TempDir(), "memdb.db"), clock.Now, gateways.StreamRetention{MaxLen: 1})
+
+	if err := cli.SetNX(ctx, "key", "value1", time.Minute); err != nil {
+		t.Fatalf("failed to SetNX: %v", err)
+	}
+	if err := cli.SetNX(ctx, "key", "value2", time.Minute); err != nil {
+		t.Fatalf("failed to SetNX: %v", err)
+	}
+	if v, err := cli.Get(ctx, "key"); err != nil || v != "value1" {
+		t.Errorf("expected %s, actual %s, err: %v", "value1", v, err)
+	}
+
+	clock.Add(30 * time.Second)
+	if err := cli.Expire(ctx, "key", time.Minute); err != nil {
+		t.Fatalf("failed to Expire: %v", err)
+	}
+	clock.Add(59 * time.Second)
+	if _, err := cli.Get(ctx, "key"); err != nil {
+		t.Errorf("expected key to be alive after Expire, err: %v", err)
+	}
+
+	clock.Add(time.Second)
+	if _, err := cli.Get(ctx, "key"); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, actual %v", err)
+	}
+	if err := cli.SetNX(ctx, "key", "value3", time.Minute); err != nil {
+		t.Fatalf("failed to SetNX: %v", err)
+	}
+	if v, err := cli.Get(ctx, "key"); err != nil || v != "value3" {
+		t.Errorf("expected %s, actual %s, err: %v", "value3", v, err)
+	}
+}
+
+func TestBoltClient_Set(t *testing.T) {
+	ctx := context.Background()
+	cli := openClient(t, filepath.Join(t.TempDir(), "memdb.db"), time.Now, gateways.StreamRetention{MaxLen: 1})
+
+	if err := cli.SAdd(ctx, "members", "a", "b", "c"); err != nil {
+		t.Fatalf("failed to SAdd: %v", err)
+	}
+	if err := cli.SRem(ctx, "members", "b"); err != nil {
+		t.Fatalf("failed to SRem: %v", err)
+	}
+
+	members, err := cli.SMembers(ctx, "members")
+	if err != nil {
+		t.Fatalf("failed to SMembers: %v", err)
+	}
+	sort.Strings(members)
+	if len(members) != 2 || members[0] != "a" || members[1] != "c" {
+		t.Errorf("expected [a c], actual %v", members)
+	}
+
+	if err := cli.SRem(ctx, "members", "a", "c"); err != nil {
+		t.Fatalf("failed to SRem: %v", err)
+	}
+	members, err = cli.SMembers(ctx, "members")
+	if err != nil || len(members) != 0 {
+		t.Errorf("expected empty members, actual %v, err: %v", members, err)
+	}
+}
+
+func TestBoltClient_Stream(t *testing.T) {
+	ctx := context.Background()
+	cli := openClient(t, filepath.Join(t.TempDir(), "memdb.db"), time.Now, gateways.StreamRetention{MaxLen: 1})
+
+	if err := cli.PublishStream(ctx, "stream", map[string]interface{}{"msg": []byte("first\xff")}); err != nil {
+		t.Fatalf("failed to PublishStream: %v", err)
+	}
+
+	id, msg, err := cli.ReadStreamLatest(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if msg != "first\xff" {
+		t.Errorf("expected %q, actual %q", "first\xff", msg)
+	}
+
+	type result struct {
+		id, msg string
+		err     error
+	}
+	ch := make(chan result)
+	go func() {
+		id, msg, err := cli.ReadStream(ctx, "stream", "msg", id)
+		ch <- result{id: id, msg: msg, err: err}
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	if err := cli.PublishStream(ctx, "stream", map[string]interface{}{"msg": "second"}); err != nil {
+		t.Fatalf("failed to PublishStream: %v", err)
+	}
+
+	select {
+	case r := <-ch:
+		if r.err != nil {
+			t.Fatalf("failed to ReadStream: %v", r.err)
+		}
+		if r.msg != "second" || r.id == id {
+			t.Errorf("expected new message, actual id: %s, msg: %s", r.id, r.msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ReadStream was not woken up by PublishStream")
+	}
+}
+
+func TestBoltClient_PublishStreamIfLatest(t *testing.T) {
+	ctx := context.Background()
+	cli := openClient(t, filepath.Join(t.TempDir(), "memdb.db"), time.Now, gateways.StreamRetention{MaxLen: 1})
+
+	if err := cli.PublishStreamIfLatest(ctx, "stream", "", map[string]interface{}{"msg": "first"}); err != nil {
+		t.Fatalf("failed to PublishStreamIfLatest to empty stream: %v", err)
+	}
+	id, _, err := cli.ReadStreamLatest(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+
+	if err := cli.PublishStreamIfLatest(ctx, "stream", id, map[string]interface{}{"msg": "second"}); err != nil {
+		t.Fatalf("failed to PublishStreamIfLatest: %v", err)
+	}
+	err = cli.PublishStreamIfLatest(ctx, "stream", id, map[string]interface{}{"msg": "third"})
+	if !errs.IsConflictError(err) {
+		t.Errorf("expected ConflictError, actual %v", err)
+	}
+
+	if _, msg, _ := cli.ReadStreamLatest(ctx, "stream", "msg"); msg != "second" {
+		t.Errorf("expected %s, actual %s", "second", msg)
+	}
+}
+
+func TestBoltClient_StreamRetention(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Unix(1620000000, 0)}
+	cli := openClient(t, filepath.Join(t.TempDir(), "memdb.db"), clock.Now, gateways.StreamRetention{MaxLen: 3, MaxAge: 10 * time.Minute})
+
+	for _, m := range []string{"1", "2", "3", "4"} {
+		if err := cli.PublishStream(ctx, "stream", map[string]interface{}{"msg": m}); err != nil {
+			t.Fatalf("failed to PublishStream: %v", err)
+		}
+		clock.Add(time.Minute)
+	}
+
+	msgs, err := cli.ReadStreamRange(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamRange: %v", err)
+	}
+	if len(msgs) != 3 || msgs[0].Message != "2" || msgs[2].Message != "4" {
+		t.Errorf("expected last 3 messages in order, actual %v", msgs)
+	}
+
+	clock.Add(15 * time.Minute)
+	if err := cli.PublishStream(ctx, "stream", map[string]interface{}{"msg": "5"}); err != nil {
+		t.Fatalf("failed to PublishStream: %v", err)
+	}
+	msgs, err = cli.ReadStreamRange(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamRange: %v", err)
+	}
+	if len(msgs) != 1 || msgs[0].Message != "5" {
+		t.Errorf("expected only the latest message, actual %v", msgs)
+	}
+}
+
+func TestBoltClient_Reopen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "memdb.db")
+	clock := &fakeClock{now: time.Unix(1620000000, 0)}
+
+	cli, err := newBoltClient(Config{Path: path}, clock.Now, gateways.StreamRetention{MaxLen: 1})
+	if err != nil {
+		t.Fatalf("failed to newBoltClient: %v", err)
+	}
+	if err := cli.Set(ctx, "login", "session", time.Hour); err != nil {
+		t.Fatalf("failed to Set: %v", err)
+	}
+	if err := cli.SAdd(ctx, "members", "a"); err != nil {
+		t.Fatalf("failed to SAdd: %v", err)
+	}
+	if err := cli.PublishStream(ctx, "stream", map[string]interface{}{"msg": "room"}); err != nil {
+		t.Fatalf("failed to PublishStream: %v", err)
+	}
+	id, _, err := cli.ReadStreamLatest(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if err := cli.Close(); err != nil {
+		t.Fatalf("failed to Close: %v", err)
+	}
+
+	// 時計が戻っても stream ID は単調増加する
+	clock.Add(-time.Minute)
+	cli = openClient(t, path, clock.Now, gateways.StreamRetention{MaxLen: 1})
+
+	if v, err := cli.Get(ctx, "login"); err != nil || v != "session" {
+		t.Errorf("expected %s, actual %s, err: %v", "session", v, err)
+	}
+	if members, err := cli.SMembers(ctx, "members"); err != nil || len(members) != 1 || members[0] != "a" {
+		t.Errorf("expected [a], actual %v, err: %v", members, err)
+	}
+	if err := cli.PublishStreamIfLatest(ctx, "stream", id, map[string]interface{}{"msg": "updated"}); err != nil {
+		t.Fatalf("failed to PublishStreamIfLatest: %v", err)
+	}
+	newID, msg, err := cli.ReadStream(ctx, "stream", "msg", id)
+	if err != nil || msg != "updated" {
+		t.Errorf("expected %s, actual %s, err: %v", "updated", msg, err)
+	}
+	if newID == id {
+		t.Errorf("expected new stream id, actual %s", newID)
+	}
+}
@@ -0,0 +1,8 @@
+package bolt
+
+// Config is
+// bbolt settings.
+type Config struct {
+	// Path is the database file. Only one process can open it at a time.
+	Path string `envconfig:"path" default:"porker-memdb.db"`
+}
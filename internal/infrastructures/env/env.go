@@ -2,20 +2,69 @@ package env
 
 import (
 	"log"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/bolt"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/grpc_web"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/jetstream"
 	"github.com/swallowarc/porker-rpc/internal/infrastructures/redis"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/rest_gateway"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/room_feed"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/sqlite"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/tracing"
+)
+
+const (
+	MemDBRedis  = "redis"
+	MemDBMemory = "memory"
+	MemDBBolt   = "bolt"
+
+	StreamTransportMemDB = "memdb"
+	StreamTransportNATS  = "nats"
+
+	StreamPayloadFormatJSON  = "json"
+	StreamPayloadFormatProto = "proto"
 )
 
 var (
-	Server Config
-	Redis  redis.Config
+	Server   Config
+	Redis    redis.Config
+	SQLite   sqlite.Config
+	Bolt     bolt.Config
+	NATS     jetstream.Config
+	OTel     tracing.Config
+	GRPCWeb  grpc_web.Config
+	REST     rest_gateway.Config
+	RoomFeed room_feed.Config
 )
 
 type (
 	Config struct {
 		IsDevelopment bool   `envconfig:"is_development" default:"true"`
 		PORT          string `envconfig:"grpc_port" default:"50051"`
+		MemDB         string `envconfig:"memdb" default:"redis"`
+		// StreamMaxLen and StreamMaxAge decide how many snapshots of each room are kept as history.
+		// With the nats transport StreamMaxAge is also the max age of the JetStream stream, which drops the rooms left longer,
+		// so it must be longer than the room timeout when it is set.
+		StreamMaxLen int64         `envconfig:"stream_max_len" default:"20"`
+		StreamMaxAge time.Duration `envconfig:"stream_max_age" default:"0"`
+		// StreamTransport is where room streams are published. "memdb" uses the mem db selected by MemDB.
+		StreamTransport string `envconfig:"stream_transport" default:"memdb"`
+		// StreamPayloadFormat is the format of the situations written to room streams, "json" or "proto".
+		// Both are read, so switch to "proto" after every instance runs a version reading it.
+		StreamPayloadFormat string `envconfig:"stream_payload_format" default:"json"`
+		// HealthProbeInterval is how often the health server pings the mem db.
+		HealthProbeInterval time.Duration `envconfig:"health_probe_interval" default:"5s"`
+		// MetricsEnabled starts the HTTP listener serving Prometheus metrics at /metrics on MetricsPort.
+		MetricsEnabled bool   `envconfig:"metrics_enabled" default:"false"`
+		MetricsPort    string `envconfig:"metrics_port" default:"9090"`
+		// TLSCertFile and TLSKeyFile enable TLS on the gRPC port and the gateways. TLSClientCAFile additionally requires client certificates (mTLS).
+		// The files are reloaded when they change on disk.
+		TLSCertFile     string `envconfig:"tls_cert_file"`
+		TLSKeyFile      string `envconfig:"tls_key_file"`
+		TLSClientCAFile string `envconfig:"tls_client_ca_file"`
 	}
 )
 
@@ -26,6 +75,23 @@ func init() {
 func setup() {
 	check(envconfig.Process("", &Server))
 	check(envconfig.Process("redis", &Redis))
+	check(envconfig.Process("sqlite", &SQLite))
+	check(envconfig.Process("bolt", &Bolt))
+	check(envconfig.Process("nats", &NATS))
+	check(envconfig.Process("otel", &OTel))
+	check(envconfig.Process("grpc_web", &GRPCWeb))
+	check(envconfig.Process("rest_gateway", &REST))
+	check(envconfig.Process("room_feed", &RoomFeed))
+
+	if Server.StreamMaxLen < 1 {
+		log.Panicf("stream_max_len must be 1 or more: %d", Server.StreamMaxLen)
+	}
+	if Server.StreamPayloadFormat != StreamPayloadFormatJSON && Server.StreamPayloadFormat != StreamPayloadFormatProto {
+		log.Panicf("stream_payload_format must be %s or %s: %s", StreamPayloadFormatJSON, StreamPayloadFormatProto, Server.StreamPayloadFormat)
+	}
+	if Server.StreamTransport == StreamTransportNATS && Server.StreamMaxAge > 0 && Server.StreamMaxAge < room.TimeoutDuration {
+		log.Panicf("stream_max_age must be %s or more with the nats transport: %s", room.TimeoutDuration, Server.StreamMaxAge)
+	}
 }
 
 func check(err error) {
@@ -1,23 +1,129 @@
 package infrastructures
 
 import (
+	"context"
+	"io"
+	"log"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/bolt"
 	"github.com/swallowarc/porker-rpc/internal/infrastructures/env"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/jetstream"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/memory"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/metrics"
 	"github.com/swallowarc/porker-rpc/internal/infrastructures/redis"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/sqlite"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/tracing"
 	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"golang.org/x/xerrors"
 )
 
 type (
 	factory struct {
-		redisClient gateways.MemDBClient
+		memDBClient  gateways.MemDBClient
+		streamClient gateways.StreamClient
+		rdbClient    gateways.RDBClient
 	}
 )
 
 func NewFactory() gateways.Factory {
+	memDBClient := tracing.TraceMemDBClient(
+		metrics.InstrumentMemDBClient(newMemDBClient(env.Server.MemDB), prometheus.DefaultRegisterer),
+	)
+
 	return &factory{
-		redisClient: redis.NewRedisClient(env.Redis),
+		memDBClient:  memDBClient,
+		streamClient: newStreamClient(env.Server.StreamTransport, memDBClient),
+		rdbClient:    newRDBClient(),
+	}
+}
+
+// newRDBClient returns nil if the archive is disabled.
+func newRDBClient() gateways.RDBClient {
+	if !env.SQLite.Enabled {
+		return nil
+	}
+	cli, err := sqlite.NewSQLiteClient(context.Background(), env.SQLite)
+	if err != nil {
+		log.Panicf("failed to create sqlite client: %v", err)
+	}
+	return cli
+}
+
+func streamRetention() gateways.StreamRetention {
+	return gateways.StreamRetention{
+		MaxLen: env.Server.StreamMaxLen,
+		MaxAge: env.Server.StreamMaxAge,
+	}
+}
+
+func newMemDBClient(memDB string) gateways.MemDBClient {
+	retention := streamRetention()
+
+	switch memDB {
+	case env.MemDBRedis:
+		cli, err := redis.NewRedisClient(env.Redis, retention)
+		if err != nil {
+			log.Panicf("failed to create redis client: %v", err)
+		}
+		return cli
+	case env.MemDBMemory:
+		return memory.NewMemoryClient(retention)
+	case env.MemDBBolt:
+		cli, err := bolt.NewBoltClient(env.Bolt, retention)
+		if err != nil {
+			log.Panicf("failed to create bolt client: %v", err)
+		}
+		return cli
+	default:
+		log.Panicf("unknown mem db: %s", memDB)
+		return nil
+	}
+}
+
+func newStreamClient(transport string, memDBClient gateways.MemDBClient) gateways.StreamClient {
+	switch transport {
+	case env.StreamTransportMemDB:
+		return memDBClient
+	case env.StreamTransportNATS:
+		cli, err := jetstream.NewJetStreamClient(env.NATS, streamRetention())
+		if err != nil {
+			log.Panicf("failed to create jetstream client: %v", err)
+		}
+		return cli
+	default:
+		log.Panicf("unknown stream transport: %s", transport)
+		return nil
 	}
 }
 
 func (f factory) MemDBClient() gateways.MemDBClient {
-	return f.redisClient
+	return f.memDBClient
+}
+
+func (f factory) StreamClient() gateways.StreamClient {
+	return f.streamClient
+}
+
+func (f factory) RDBClient() gateways.RDBClient {
+	return f.rdbClient
+}
+
+func (f factory) Close() error {
+	// the mem db client is also the stream client when it is used as the transport
+	if c, ok := f.streamClient.(io.Closer); ok && f.streamClient != f.memDBClient {
+		if err := c.Close(); err != nil {
+			return xerrors.Errorf("failed to close stream client: %w", err)
+		}
+	}
+	if err := f.memDBClient.Close(); err != nil {
+		return xerrors.Errorf("failed to close mem db client: %w", err)
+	}
+	if f.rdbClient == nil {
+		return nil
+	}
+	if err := f.rdbClient.Close(); err != nil {
+		return xerrors.Errorf("failed to close rdb client: %w", err)
+	}
+	return nil
 }
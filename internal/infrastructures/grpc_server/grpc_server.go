@@ -2,6 +2,7 @@ package grpc_server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net"
@@ -15,6 +16,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"golang.org/x/xerrors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
@@ -30,6 +32,29 @@ type (
 	}
 	ControllerRegisters []ControllerRegister
 
+	// Drainer is implemented by registers that need to know that the server is shutting down.
+	// Drain is called before GracefulStop, while the server still accepts requests.
+	Drainer interface {
+		Drain()
+	}
+
+	// Gateway serves another protocol in front of the gRPC server, such as gRPC-Web.
+	// Serve is called after the gRPC server starts and blocks until Shutdown is called.
+	// tlsConfig is the config of the gRPC port, which is nil when TLS is disabled,
+	// and gateways serve TLS with it so that clients are verified the same as gRPC clients.
+	Gateway interface {
+		Serve(server *grpc.Server, tlsConfig *tls.Config) error
+		Shutdown(ctx context.Context) error
+	}
+	Gateways []Gateway
+
+	// Interceptors are chained after the interceptor for logging.
+	// Include the recovery interceptors right after the tracing ones, so that a panic anywhere below is recovered as codes.Internal.
+	Interceptors struct {
+		Unary  []grpc.UnaryServerInterceptor
+		Stream []grpc.StreamServerInterceptor
+	}
+
 	InitFunc   func()
 	CloserFunc func()
 
@@ -37,7 +62,10 @@ type (
 		logger              *zap.Logger
 		port                string
 		isDevelop           bool
+		tlsConfig           TLSConfig
 		controllerRegisters ControllerRegisters
+		interceptors        Interceptors
+		gateways            Gateways
 		initFunction        InitFunc
 		closerFunction      CloserFunc
 	}
@@ -56,7 +84,10 @@ func NewGRPCServer(
 	logger *zap.Logger,
 	port string,
 	isDevelop bool,
+	tlsConfig TLSConfig,
 	controllerRegisters ControllerRegisters,
+	interceptors Interceptors,
+	gateways Gateways,
 	initFunction InitFunc,
 	closerFunction CloserFunc,
 ) GRPCServer {
@@ -64,7 +95,10 @@ func NewGRPCServer(
 		logger:              logger,
 		port:                port,
 		isDevelop:           isDevelop,
+		tlsConfig:           tlsConfig,
 		controllerRegisters: controllerRegisters,
+		interceptors:        interceptors,
+		gateways:            gateways,
 		initFunction:        initFunction,
 		closerFunction:      closerFunction,
 	}
@@ -80,7 +114,10 @@ func (s *grpcServer) RunGRPCServer(ctx context.Context) {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	server := s.newServer()
+	server, tlsConfig, err := s.newServer()
+	if err != nil {
+		log.Fatalf("failed to create server: %v", err)
+	}
 
 	s.logger.Info(fmt.Sprintf("Startup using port : %s", s.port))
 	go func() {
@@ -88,8 +125,15 @@ func (s *grpcServer) RunGRPCServer(ctx context.Context) {
 			s.logger.Panic("failed to gRPC Server running", zap.Error(err))
 		}
 	}()
+	for _, g := range s.gateways {
+		go func(g Gateway) {
+			if err := g.Serve(server, tlsConfig); err != nil {
+				s.logger.Panic("failed to gateway running", zap.Error(err))
+			}
+		}(g)
+	}
 
-	quit := make(chan os.Signal)
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, catchSignals...)
 
 	select {
@@ -99,6 +143,13 @@ func (s *grpcServer) RunGRPCServer(ctx context.Context) {
 		s.logger.Info("!! Receive signal !!", zap.String("signal", sig.String()))
 	}
 
+	s.logger.Info("Draining gRPC Server ...")
+	for _, c := range s.controllerRegisters {
+		if d, ok := c.(Drainer); ok {
+			d.Drain()
+		}
+	}
+
 	wait := time.Duration(5)
 	if s.isDevelop {
 		wait = 1
@@ -108,6 +159,15 @@ func (s *grpcServer) RunGRPCServer(ctx context.Context) {
 
 	<-sdCtx.Done()
 
+	s.logger.Info("Closing gateways ...")
+	gwCtx, gwCancel := context.WithTimeout(context.Background(), wait*time.Second)
+	defer gwCancel()
+	for _, g := range s.gateways {
+		if err := g.Shutdown(gwCtx); err != nil {
+			s.logger.Warn("failed to shutdown gateway", zap.Error(err))
+		}
+	}
+
 	s.logger.Info("Closing gRPC Server ...")
 	server.GracefulStop()
 	s.closerFunction()
@@ -115,7 +175,7 @@ func (s *grpcServer) RunGRPCServer(ctx context.Context) {
 	s.logger.Info("Shutdown gRPC Server")
 }
 
-func (s *grpcServer) newServer() *grpc.Server {
+func (s *grpcServer) newServer() (*grpc.Server, *tls.Config, error) {
 	var (
 		zapOpts = []grpc_zap.Option{
 			grpc_zap.WithDurationField(func(duration time.Duration) zapcore.Field {
@@ -128,18 +188,32 @@ func (s *grpcServer) newServer() *grpc.Server {
 		}
 	)
 
-	grpc_zap.ReplaceGrpcLoggerV2(s.logger)
-	server := grpc.NewServer(
-		grpc_middleware.WithUnaryServerChain(
-			grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_zap.UnaryServerInterceptor(s.logger, zapOpts...),
-		),
-		grpc_middleware.WithStreamServerChain(
-			grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_zap.StreamServerInterceptor(s.logger, zapOpts...),
-		),
+	unaryInterceptors := append([]grpc.UnaryServerInterceptor{
+		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		grpc_zap.UnaryServerInterceptor(s.logger, zapOpts...),
+	}, s.interceptors.Unary...)
+	streamInterceptors := append([]grpc.StreamServerInterceptor{
+		grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		grpc_zap.StreamServerInterceptor(s.logger, zapOpts...),
+	}, s.interceptors.Stream...)
+
+	opts := []grpc.ServerOption{
+		grpc_middleware.WithUnaryServerChain(unaryInterceptors...),
+		grpc_middleware.WithStreamServerChain(streamInterceptors...),
 		grpc.KeepaliveParams(kasp),
-	)
+	}
+	var tlsConfig *tls.Config
+	if s.tlsConfig.Enabled() {
+		var err error
+		if tlsConfig, err = newServerTLSConfig(s.logger, s.tlsConfig); err != nil {
+			return nil, nil, xerrors.Errorf("failed to newServerTLSConfig: %w", err)
+		}
+		opts = append(opts, grpc.Creds(newServerCredentials(tlsConfig)))
+		s.logger.Info("TLS enabled", zap.Bool("mtls", s.tlsConfig.ClientCAFile != ""))
+	}
+
+	grpc_zap.ReplaceGrpcLoggerV2(s.logger)
+	server := grpc.NewServer(opts...)
 
 	for _, c := range s.controllerRegisters {
 		c.Register(server)
@@ -148,5 +222,5 @@ func (s *grpcServer) newServer() *grpc.Server {
 	if s.isDevelop {
 		reflection.Register(server)
 	}
-	return server
+	return server, tlsConfig, nil
 }
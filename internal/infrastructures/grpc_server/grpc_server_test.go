@@ -2,6 +2,7 @@ package grpc_server
 
 import (
 	"context"
+	"crypto/tls"
 	"sync"
 	"testing"
 	"time"
@@ -12,10 +13,35 @@ import (
 
 type (
 	fakeRegister struct{}
+
+	fakeDrainRegister struct {
+		fakeRegister
+		drained bool
+	}
+
+	fakeGateway struct {
+		served chan struct{}
+		done   chan struct{}
+	}
 )
 
 func (f fakeRegister) Register(grpc.ServiceRegistrar) {}
 
+func (f *fakeDrainRegister) Drain() {
+	f.drained = true
+}
+
+func (f *fakeGateway) Serve(*grpc.Server, *tls.Config) error {
+	close(f.served)
+	<-f.done
+	return nil
+}
+
+func (f *fakeGateway) Shutdown(context.Context) error {
+	close(f.done)
+	return nil
+}
+
 func TestGrpcServer_RunGRPCServer(t *testing.T) {
 	ctx := context.Background()
 
@@ -23,7 +49,9 @@ func TestGrpcServer_RunGRPCServer(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to new zap logger: %v", err)
 	}
-	srv := NewGRPCServer(zapLogger, "18080", true, ControllerRegisters{fakeRegister{}}, func() {}, func() {})
+	drainer := &fakeDrainRegister{}
+	gateway := &fakeGateway{served: make(chan struct{}), done: make(chan struct{})}
+	srv := NewGRPCServer(zapLogger, "18080", true, TLSConfig{}, ControllerRegisters{fakeRegister{}, drainer}, Interceptors{}, Gateways{gateway}, func() {}, func() {})
 
 	ctx2, cancel := context.WithTimeout(ctx, 1*time.Second)
 	defer cancel()
@@ -35,4 +63,18 @@ func TestGrpcServer_RunGRPCServer(t *testing.T) {
 		srv.RunGRPCServer(ctx2)
 	}()
 	wg.Wait()
+
+	if !drainer.drained {
+		t.Error("Drain was not called at shutdown")
+	}
+	select {
+	case <-gateway.served:
+	default:
+		t.Error("gateway was not served")
+	}
+	select {
+	case <-gateway.done:
+	default:
+		t.Error("gateway was not shut down")
+	}
 }
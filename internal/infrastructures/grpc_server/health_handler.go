@@ -2,50 +2,194 @@ package grpc_server
 
 import (
 	"context"
-	"fmt"
+	"sync"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
 	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	health "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// overallService is the service name of the health of the whole server.
+	overallService = ""
+)
+
 type (
+	// healthServer probes the mem db periodically and keeps the serving status of each service.
+	// All services depend on the mem db, so they share the result of the probe.
 	healthServer struct {
+		logger   *zap.Logger
 		memDBCli gateways.MemDBClient
+		interval time.Duration
+
+		mu       sync.Mutex
+		statuses map[string]health.HealthCheckResponse_ServingStatus
+		watchers map[string]map[chan health.HealthCheckResponse_ServingStatus]struct{}
+		draining bool
+		drained  chan struct{}
 	}
 
 	healthRegister struct {
-		healthServer health.HealthServer
+		healthServer *healthServer
 	}
 )
 
-func NewHealthRegister(memDBCli gateways.MemDBClient) ControllerRegister {
+func NewHealthRegister(logger *zap.Logger, memDBCli gateways.MemDBClient, interval time.Duration, services ...string) ControllerRegister {
 	return &healthRegister{
-		healthServer: newHealthServer(memDBCli),
+		healthServer: newHealthServer(logger, memDBCli, interval, services...),
 	}
 }
 
 func (hr *healthRegister) Register(grpcServer grpc.ServiceRegistrar) {
 	health.RegisterHealthServer(grpcServer, hr.healthServer)
+	go hr.healthServer.run()
+}
+
+// Drain reports NOT_SERVING for all services so that load balancers stop sending requests.
+func (hr *healthRegister) Drain() {
+	hr.healthServer.drain()
 }
 
-func newHealthServer(memDBCli gateways.MemDBClient) health.HealthServer {
-	return &healthServer{
+func newHealthServer(logger *zap.Logger, memDBCli gateways.MemDBClient, interval time.Duration, services ...string) *healthServer {
+	hs := &healthServer{
+		logger:   logger,
 		memDBCli: memDBCli,
+		interval: interval,
+		statuses: map[string]health.HealthCheckResponse_ServingStatus{},
+		watchers: map[string]map[chan health.HealthCheckResponse_ServingStatus]struct{}{},
+		drained:  make(chan struct{}),
 	}
+	for _, service := range append([]string{overallService}, services...) {
+		hs.statuses[service] = health.HealthCheckResponse_NOT_SERVING
+		hs.watchers[service] = map[chan health.HealthCheckResponse_ServingStatus]struct{}{}
+	}
+	return hs
 }
 
-func (h *healthServer) Check(ctx context.Context, _ *health.HealthCheckRequest) (*health.HealthCheckResponse, error) {
-	if err := h.memDBCli.Ping(ctx); err != nil {
-		panic(fmt.Errorf("failed to ping redis in health check: %v", err))
-	}
+func (h *healthServer) Check(ctx context.Context, req *health.HealthCheckRequest) (*health.HealthCheckResponse, error) {
+	h.probe(ctx)
 
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	st, ok := h.statuses[req.Service]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service: %s", req.Service)
+	}
 	return &health.HealthCheckResponse{
-		Status: health.HealthCheckResponse_SERVING,
+		Status: st,
 	}, nil
 }
 
-func (h *healthServer) Watch(*health.HealthCheckRequest, health.Health_WatchServer) error {
-	return status.Error(codes.Unimplemented, "watch is not implemented.")
+func (h *healthServer) Watch(req *health.HealthCheckRequest, stream health.Health_WatchServer) error {
+	// 最新の status だけを送れば良いので、送信が遅れた場合は古い status を捨てる
+	ch := make(chan health.HealthCheckResponse_ServingStatus, 1)
+
+	h.mu.Lock()
+	st, ok := h.statuses[req.Service]
+	if !ok {
+		st = health.HealthCheckResponse_SERVICE_UNKNOWN
+	} else {
+		h.watchers[req.Service][ch] = struct{}{}
+	}
+	h.mu.Unlock()
+	if ok {
+		defer func() {
+			h.mu.Lock()
+			delete(h.watchers[req.Service], ch)
+			h.mu.Unlock()
+		}()
+	}
+
+	if err := stream.Send(&health.HealthCheckResponse{Status: st}); err != nil {
+		return status.Errorf(codes.Canceled, "failed to send health status: %v", err)
+	}
+
+	for {
+		select {
+		case st := <-ch:
+			if err := stream.Send(&health.HealthCheckResponse{Status: st}); err != nil {
+				return status.Errorf(codes.Canceled, "failed to send health status: %v", err)
+			}
+		case <-h.drained:
+			// GracefulStop waits for all streams, so finish after NOT_SERVING has been sent.
+			if ok {
+				return stream.Send(&health.HealthCheckResponse{Status: health.HealthCheckResponse_NOT_SERVING})
+			}
+			return nil
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream has ended")
+		}
+	}
+}
+
+// run probes the mem db until the server is drained.
+func (h *healthServer) run() {
+	ctx := loggers.LoggerToContext(context.Background(), h.logger)
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		h.probe(ctx)
+
+		select {
+		case <-h.drained:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *healthServer) probe(ctx context.Context) {
+	st := health.HealthCheckResponse_SERVING
+	if err := h.memDBCli.Ping(ctx); err != nil {
+		loggers.Logger(ctx).Warn("failed to ping mem db in health check", zap.Error(err))
+		st = health.HealthCheckResponse_NOT_SERVING
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.draining {
+		return
+	}
+	for service := range h.statuses {
+		h.setStatus(service, st)
+	}
+}
+
+func (h *healthServer) drain() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.draining {
+		return
+	}
+	h.draining = true
+	for service := range h.statuses {
+		h.setStatus(service, health.HealthCheckResponse_NOT_SERVING)
+	}
+	close(h.drained)
+}
+
+// setStatus updates the status and notifies the watchers of the service if it has changed.
+// It must be called while holding the lock.
+func (h *healthServer) setStatus(service string, st health.HealthCheckResponse_ServingStatus) {
+	if h.statuses[service] == st {
+		return
+	}
+	h.statuses[service] = st
+
+	for ch := range h.watchers[service] {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- st
+	}
 }
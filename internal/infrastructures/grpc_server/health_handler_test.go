@@ -0,0 +1,146 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_gateways "github.com/swallowarc/porker-rpc/internal/tests/mocks/gateways"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	health "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testService = "porker.PorkerService"
+)
+
+type (
+	fakeWatchServer struct {
+		grpc.ServerStream
+		ctx context.Context
+		ch  chan health.HealthCheckResponse_ServingStatus
+	}
+)
+
+func (s *fakeWatchServer) Send(resp *health.HealthCheckResponse) error {
+	s.ch <- resp.Status
+	return nil
+}
+
+func (s *fakeWatchServer) Context() context.Context {
+	return s.ctx
+}
+
+func TestHealthServer_Check(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memDBCli := mock_gateways.NewMockMemDBClient(ctrl)
+	hs := newHealthServer(zap.NewNop(), memDBCli, time.Hour, testService)
+
+	memDBCli.EXPECT().Ping(gomock.Any()).Return(nil)
+	resp, err := hs.Check(ctx, &health.HealthCheckRequest{Service: testService})
+	if err != nil || resp.Status != health.HealthCheckResponse_SERVING {
+		t.Errorf("expected %s, actual %v, err: %v", health.HealthCheckResponse_SERVING, resp, err)
+	}
+
+	memDBCli.EXPECT().Ping(gomock.Any()).Return(xerrors.New("connection refused"))
+	resp, err = hs.Check(ctx, &health.HealthCheckRequest{})
+	if err != nil || resp.Status != health.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected %s, actual %v, err: %v", health.HealthCheckResponse_NOT_SERVING, resp, err)
+	}
+
+	memDBCli.EXPECT().Ping(gomock.Any()).Return(nil)
+	if _, err := hs.Check(ctx, &health.HealthCheckRequest{Service: "unknown"}); status.Code(err) != codes.NotFound {
+		t.Errorf("expected %s, actual %v", codes.NotFound, err)
+	}
+}
+
+func TestHealthServer_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	memDBCli := mock_gateways.NewMockMemDBClient(ctrl)
+	hs := newHealthServer(zap.NewNop(), memDBCli, time.Hour, testService)
+
+	stream := &fakeWatchServer{ctx: ctx, ch: make(chan health.HealthCheckResponse_ServingStatus, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- hs.Watch(&health.HealthCheckRequest{Service: testService}, stream)
+	}()
+
+	expect := func(expected health.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		select {
+		case st := <-stream.ch:
+			if st != expected {
+				t.Errorf("expected %s, actual %s", expected, st)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%s was not sent", expected)
+		}
+	}
+
+	expect(health.HealthCheckResponse_NOT_SERVING)
+
+	memDBCli.EXPECT().Ping(gomock.Any()).Return(nil)
+	hs.probe(ctx)
+	expect(health.HealthCheckResponse_SERVING)
+
+	// 変化がなければ送らない
+	memDBCli.EXPECT().Ping(gomock.Any()).Return(nil)
+	hs.probe(ctx)
+
+	memDBCli.EXPECT().Ping(gomock.Any()).Return(xerrors.New("connection refused"))
+	hs.probe(ctx)
+	expect(health.HealthCheckResponse_NOT_SERVING)
+
+	memDBCli.EXPECT().Ping(gomock.Any()).Return(nil)
+	hs.probe(ctx)
+	expect(health.HealthCheckResponse_SERVING)
+
+	hs.drain()
+	expect(health.HealthCheckResponse_NOT_SERVING)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("expected nil, actual %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Watch did not finish after drain")
+	}
+
+	// drain 後は ping が成功しても NOT_SERVING のまま
+	memDBCli.EXPECT().Ping(gomock.Any()).Return(nil)
+	resp, err := hs.Check(ctx, &health.HealthCheckRequest{})
+	if err != nil || resp.Status != health.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("expected %s, actual %v, err: %v", health.HealthCheckResponse_NOT_SERVING, resp, err)
+	}
+}
+
+func TestHealthServer_WatchUnknownService(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	hs := newHealthServer(zap.NewNop(), nil, time.Hour)
+
+	stream := &fakeWatchServer{ctx: ctx, ch: make(chan health.HealthCheckResponse_ServingStatus, 1)}
+	done := make(chan error, 1)
+	go func() {
+		done <- hs.Watch(&health.HealthCheckRequest{Service: "unknown"}, stream)
+	}()
+
+	if st := <-stream.ch; st != health.HealthCheckResponse_SERVICE_UNKNOWN {
+		t.Errorf("expected %s, actual %s", health.HealthCheckResponse_SERVICE_UNKNOWN, st)
+	}
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Errorf("expected %s, actual %v", codes.Canceled, err)
+	}
+}
@@ -0,0 +1,134 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/auth"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/commons/tracers"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// LoginIDKey and SessionIDKey are the metadata keys of the login returned by Login.
+	LoginIDKey   = "porker-login-id"
+	SessionIDKey = "porker-session-id"
+
+	// streamReauthInterval は stream の session を確認し直す間隔。login の session idle timeout より短くする
+	streamReauthInterval = time.Minute
+)
+
+type (
+	Authenticator interface {
+		Authenticate(ctx context.Context, login *porker.Login) error
+	}
+
+	// PublicMethods are full method names that do not require a login.
+	// A name ending with "/" matches all methods of the service.
+	PublicMethods []string
+)
+
+func (pm PublicMethods) contains(fullMethod string) bool {
+	for _, m := range pm {
+		if m == fullMethod || strings.HasSuffix(m, "/") && strings.HasPrefix(fullMethod, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthUnaryServerInterceptor authenticates the login in the metadata and stores it in the context.
+// It returns errs.UnauthenticatedError, so chain it after StatusUnaryServerInterceptor.
+func AuthUnaryServerInterceptor(authenticator Authenticator, publicMethods PublicMethods) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if publicMethods.contains(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		newCtx, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
+		}
+		return handler(newCtx, req)
+	}
+}
+
+// AuthStreamServerInterceptor is the stream version of AuthUnaryServerInterceptor.
+// It authenticates the login again while the stream is open, which keeps the session in use,
+// and cancels the stream once the session is no longer valid.
+func AuthStreamServerInterceptor(authenticator Authenticator, publicMethods PublicMethods) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if publicMethods.contains(info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		newCtx, err := authenticate(stream.Context(), authenticator)
+		if err != nil {
+			return err
+		}
+		newCtx, cancel := context.WithCancel(newCtx)
+		defer cancel()
+		go reauthenticate(newCtx, cancel, authenticator, streamReauthInterval)
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = newCtx
+		return handler(srv, wrapped)
+	}
+}
+
+// reauthenticate authenticates the login in ctx every interval until ctx is done, and cancels ctx when it fails.
+func reauthenticate(ctx context.Context, cancel context.CancelFunc, authenticator Authenticator, interval time.Duration) {
+	login, _ := auth.Login(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := authenticator.Authenticate(ctx, login); err != nil {
+				if ctx.Err() == nil {
+					loggers.Logger(ctx).Info("stream closed by authentication", zap.String("login_id", login.LoginId), zap.Error(err))
+				}
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func authenticate(ctx context.Context, authenticator Authenticator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	login := &porker.Login{
+		LoginId:   firstValue(md, LoginIDKey),
+		SessionId: firstValue(md, SessionIDKey),
+	}
+	if login.LoginId == "" || login.SessionId == "" {
+		return nil, errs.NewUnauthenticatedError(fmt.Sprintf("%s and %s are required in metadata", LoginIDKey, SessionIDKey))
+	}
+
+	if err := authenticator.Authenticate(ctx, login); err != nil {
+		return nil, xerrors.Errorf("failed to Authenticate: %w", err)
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(tracers.LoginID(login.LoginId))
+	return auth.LoginToContext(ctx, login), nil
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
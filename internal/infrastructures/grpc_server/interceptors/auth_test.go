@@ -0,0 +1,150 @@
+package interceptors
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/auth"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type (
+	fakeAuthenticator map[string]string
+
+	// revocableAuthenticator authenticates every login until it is revoked.
+	revocableAuthenticator struct {
+		calls   atomic.Int32
+		revoked atomic.Bool
+	}
+
+	fakeServerStream struct {
+		grpc.ServerStream
+		ctx context.Context
+	}
+)
+
+func (a fakeAuthenticator) Authenticate(_ context.Context, login *porker.Login) error {
+	if sessionID, ok := a[login.LoginId]; !ok || sessionID != login.SessionId {
+		return errs.NewUnauthenticatedError("session id does not match")
+	}
+	return nil
+}
+
+func (a *revocableAuthenticator) Authenticate(context.Context, *porker.Login) error {
+	a.calls.Add(1)
+	if a.revoked.Load() {
+		return errs.NewUnauthenticatedError("session id does not match")
+	}
+	return nil
+}
+
+func (s fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func incomingContext(loginID, sessionID string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(LoginIDKey, loginID, SessionIDKey, sessionID))
+}
+
+func TestAuthUnaryServerInterceptor(t *testing.T) {
+	interceptor := AuthUnaryServerInterceptor(fakeAuthenticator{"alice": "session"}, PublicMethods{
+		"/porker.PorkerService/Login",
+		"/grpc.health.v1.Health/",
+	})
+
+	tests := map[string]struct {
+		ctx        context.Context
+		fullMethod string
+		authorized bool
+		loginID    string
+	}{
+		"authenticated":     {ctx: incomingContext("alice", "session"), fullMethod: "/porker.PorkerService/Voting", authorized: true, loginID: "alice"},
+		"no metadata":       {ctx: context.Background(), fullMethod: "/porker.PorkerService/Voting"},
+		"session mismatch":  {ctx: incomingContext("alice", "other"), fullMethod: "/porker.PorkerService/Voting"},
+		"unknown login":     {ctx: incomingContext("bob", "session"), fullMethod: "/porker.PorkerService/Voting"},
+		"public method":     {ctx: context.Background(), fullMethod: "/porker.PorkerService/Login", authorized: true},
+		"public service":    {ctx: context.Background(), fullMethod: "/grpc.health.v1.Health/Check", authorized: true},
+		"not public prefix": {ctx: context.Background(), fullMethod: "/porker.PorkerService/LoginX"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var loginID string
+			_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.fullMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				if login, ok := auth.Login(ctx); ok {
+					loginID = login.LoginId
+				}
+				return nil, nil
+			})
+
+			if tt.authorized && err != nil {
+				t.Errorf("expected no error, actual %v", err)
+			}
+			if !tt.authorized && !errs.IsUnauthenticatedError(err) {
+				t.Errorf("expected UnauthenticatedError, actual %v", err)
+			}
+			if loginID != tt.loginID {
+				t.Errorf("expected login %q, actual %q", tt.loginID, loginID)
+			}
+		})
+	}
+}
+
+func TestAuthStreamServerInterceptor(t *testing.T) {
+	interceptor := AuthStreamServerInterceptor(fakeAuthenticator{"alice": "session"}, nil)
+	info := &grpc.StreamServerInfo{FullMethod: "/porker.PorkerService/EnterRoom"}
+
+	var loginID string
+	err := interceptor(nil, fakeServerStream{ctx: incomingContext("alice", "session")}, info, func(_ interface{}, stream grpc.ServerStream) error {
+		if login, ok := auth.Login(stream.Context()); ok {
+			loginID = login.LoginId
+		}
+		return nil
+	})
+	if err != nil || loginID != "alice" {
+		t.Errorf("expected login alice, actual %q, err: %v", loginID, err)
+	}
+
+	err = interceptor(nil, fakeServerStream{ctx: incomingContext("alice", "other")}, info, func(interface{}, grpc.ServerStream) error {
+		t.Error("handler must not be called")
+		return nil
+	})
+	if !errs.IsUnauthenticatedError(err) {
+		t.Errorf("expected UnauthenticatedError, actual %v", err)
+	}
+}
+
+func TestReauthenticate(t *testing.T) {
+	authenticator := &revocableAuthenticator{}
+	ctx, cancel := context.WithCancel(auth.LoginToContext(context.Background(), &porker.Login{LoginId: "alice", SessionId: "session"}))
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		reauthenticate(ctx, cancel, authenticator, 10*time.Millisecond)
+		close(done)
+	}()
+
+	// session が有効な間は stream を閉じない
+	for authenticator.calls.Load() < 2 {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if ctx.Err() != nil {
+		t.Fatalf("expected the stream to be open, actual %v", ctx.Err())
+	}
+
+	authenticator.revoked.Store(true)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected reauthenticate to stop after the session was revoked")
+	}
+	if ctx.Err() == nil {
+		t.Error("expected the stream to be canceled")
+	}
+}
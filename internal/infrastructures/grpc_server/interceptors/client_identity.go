@@ -0,0 +1,40 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/swallowarc/porker-rpc/internal/commons/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// ClientCNKey is the log tag and span attribute of the common name of the client certificate.
+const ClientCNKey = "peer.client_cn"
+
+// ClientIdentityUnaryServerInterceptor tags the logs and the span with the client certificate identity of mTLS connections.
+// Chain it after the interceptors that create the tags and the span.
+func ClientIdentityUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		tagClientIdentity(ctx)
+		return handler(ctx, req)
+	}
+}
+
+// ClientIdentityStreamServerInterceptor is the stream version of ClientIdentityUnaryServerInterceptor.
+func ClientIdentityStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		tagClientIdentity(stream.Context())
+		return handler(srv, stream)
+	}
+}
+
+func tagClientIdentity(ctx context.Context) {
+	id, ok := auth.ClientIdentityFromContext(ctx)
+	if !ok {
+		return
+	}
+	grpc_ctxtags.Extract(ctx).Set(ClientCNKey, id.CommonName)
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(ClientCNKey, id.CommonName))
+}
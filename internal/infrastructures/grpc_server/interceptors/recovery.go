@@ -0,0 +1,28 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryUnaryServerInterceptor recovers from panics in handlers and returns codes.Internal instead.
+// Chain it after the zap interceptor so that the panic is logged with the request fields.
+func RecoveryUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return grpc_recovery.UnaryServerInterceptor(grpc_recovery.WithRecoveryHandlerContext(recoveryHandler))
+}
+
+// RecoveryStreamServerInterceptor is the stream version of RecoveryUnaryServerInterceptor.
+func RecoveryStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return grpc_recovery.StreamServerInterceptor(grpc_recovery.WithRecoveryHandlerContext(recoveryHandler))
+}
+
+func recoveryHandler(ctx context.Context, p interface{}) error {
+	loggers.Logger(ctx).Error("recovered from panic", zap.Any("panic", p), zap.Stack("stack"))
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,32 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoveryUnaryServerInterceptor(t *testing.T) {
+	interceptor := RecoveryUnaryServerInterceptor()
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	if code := status.Code(err); code != codes.Internal {
+		t.Errorf("expected %s, actual %s", codes.Internal, code)
+	}
+}
+
+func TestRecoveryStreamServerInterceptor(t *testing.T) {
+	interceptor := RecoveryStreamServerInterceptor()
+
+	err := interceptor(nil, fakeServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{}, func(interface{}, grpc.ServerStream) error {
+		panic("boom")
+	})
+	if code := status.Code(err); code != codes.Internal {
+		t.Errorf("expected %s, actual %s", codes.Internal, code)
+	}
+}
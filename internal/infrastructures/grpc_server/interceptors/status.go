@@ -0,0 +1,99 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"golang.org/x/xerrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ErrorDomain is the domain of errdetails.ErrorInfo attached to error statuses.
+	ErrorDomain = "porker.swallowarc.github.com"
+
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonSessionMismatch  = "SESSION_MISMATCH"
+	ReasonUnauthenticated  = "UNAUTHENTICATED"
+	ReasonInvalidState     = "INVALID_STATE"
+	ReasonInvalidArgument  = "INVALID_ARGUMENT"
+	ReasonPermissionDenied = "PERMISSION_DENIED"
+	ReasonAlreadyExists    = "ALREADY_EXISTS"
+	ReasonConflict         = "CONFLICT"
+	ReasonInternal         = "INTERNAL"
+)
+
+type (
+	errorMapping struct {
+		is     func(err error) bool
+		code   codes.Code
+		reason string
+	}
+)
+
+var (
+	errorMappings = []errorMapping{
+		{is: errs.IsNotFoundError, code: codes.NotFound, reason: ReasonNotFound},
+		{is: errs.IsSessionMismatchError, code: codes.FailedPrecondition, reason: ReasonSessionMismatch},
+		{is: errs.IsUnauthenticatedError, code: codes.Unauthenticated, reason: ReasonUnauthenticated},
+		{is: errs.IsInvalidStateError, code: codes.FailedPrecondition, reason: ReasonInvalidState},
+		{is: errs.IsInvalidArgumentError, code: codes.InvalidArgument, reason: ReasonInvalidArgument},
+		{is: errs.IsPermissionDeniedError, code: codes.PermissionDenied, reason: ReasonPermissionDenied},
+		{is: errs.IsAlreadyExistsError, code: codes.AlreadyExists, reason: ReasonAlreadyExists},
+		{is: errs.IsConflictError, code: codes.Aborted, reason: ReasonConflict},
+	}
+)
+
+// StatusUnaryServerInterceptor converts errors returned by handlers into gRPC statuses.
+func StatusUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, toStatusError(err)
+	}
+}
+
+// StatusStreamServerInterceptor is the stream version of StatusUnaryServerInterceptor.
+func StatusStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return toStatusError(handler(srv, stream))
+	}
+}
+
+// toStatusError maps the error types of errs to gRPC codes with errdetails.ErrorInfo,
+// so that clients can handle errors by the code and the reason instead of the message.
+// Errors that are already statuses are returned as they are.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case xerrors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case xerrors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	code, reason := codes.Internal, ReasonInternal
+	for _, m := range errorMappings {
+		if m.is(err) {
+			code, reason = m.code, m.reason
+			break
+		}
+	}
+
+	st, detailErr := status.New(code, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: ErrorDomain,
+	})
+	if detailErr != nil {
+		return status.Error(code, err.Error())
+	}
+	return st.Err()
+}
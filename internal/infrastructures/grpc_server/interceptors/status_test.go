@@ -0,0 +1,78 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"golang.org/x/xerrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusUnaryServerInterceptor(t *testing.T) {
+	interceptor := StatusUnaryServerInterceptor()
+
+	tests := map[string]struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		"not found":         {err: errs.NewNotFoundError("room"), code: codes.NotFound, reason: ReasonNotFound},
+		"session mismatch":  {err: errs.NewPreConditionError("session"), code: codes.FailedPrecondition, reason: ReasonSessionMismatch},
+		"unauthenticated":   {err: errs.NewUnauthenticatedError("login"), code: codes.Unauthenticated, reason: ReasonUnauthenticated},
+		"invalid state":     {err: errs.NewInvalidStateError("state"), code: codes.FailedPrecondition, reason: ReasonInvalidState},
+		"invalid argument":  {err: errs.NewInvalidArgumentError("argument"), code: codes.InvalidArgument, reason: ReasonInvalidArgument},
+		"permission denied": {err: errs.NewPermissionDeniedError("member"), code: codes.PermissionDenied, reason: ReasonPermissionDenied},
+		"already exists":    {err: errs.NewAlreadyExistsError("login"), code: codes.AlreadyExists, reason: ReasonAlreadyExists},
+		"conflict":          {err: errs.NewConflictError("room"), code: codes.Aborted, reason: ReasonConflict},
+		"unknown":           {err: xerrors.New("unknown"), code: codes.Internal, reason: ReasonInternal},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(context.Context, interface{}) (interface{}, error) {
+				return nil, xerrors.Errorf("failed to handle: %w", tt.err)
+			})
+
+			st := status.Convert(err)
+			if st.Code() != tt.code {
+				t.Errorf("expected %s, actual %s", tt.code, st.Code())
+			}
+			details := st.Details()
+			if len(details) != 1 {
+				t.Fatalf("expected one detail, actual %v", details)
+			}
+			info, ok := details[0].(*errdetails.ErrorInfo)
+			if !ok || info.Reason != tt.reason || info.Domain != ErrorDomain {
+				t.Errorf("expected reason %s, actual %v", tt.reason, details[0])
+			}
+		})
+	}
+}
+
+func TestStatusStreamServerInterceptor(t *testing.T) {
+	interceptor := StatusStreamServerInterceptor()
+	info := &grpc.StreamServerInfo{}
+
+	err := interceptor(nil, nil, info, func(interface{}, grpc.ServerStream) error {
+		return xerrors.Errorf("failed to Listen: %w", context.Canceled)
+	})
+	if code := status.Code(err); code != codes.Canceled {
+		t.Errorf("expected %s, actual %s", codes.Canceled, code)
+	}
+
+	// handler が返した status はそのまま返す
+	err = interceptor(nil, nil, info, func(interface{}, grpc.ServerStream) error {
+		return status.Error(codes.ResourceExhausted, "too many")
+	})
+	if code := status.Code(err); code != codes.ResourceExhausted {
+		t.Errorf("expected %s, actual %s", codes.ResourceExhausted, code)
+	}
+
+	if err := interceptor(nil, nil, info, func(interface{}, grpc.ServerStream) error { return nil }); err != nil {
+		t.Errorf("expected nil, actual %v", err)
+	}
+}
@@ -0,0 +1,206 @@
+package grpc_server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/auth"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	certCheckInterval = time.Second
+
+	// inProcessNetwork is the network of the connections of gateways dialing the server in the process.
+	inProcessNetwork = "bufconn"
+)
+
+type (
+	// TLSConfig enables TLS on the gRPC listener and the gateways when CertFile and KeyFile are given,
+	// and additionally requires client certificates signed by ClientCAFile when it is given.
+	// The files are reloaded when they change on disk.
+	TLSConfig struct {
+		CertFile     string
+		KeyFile      string
+		ClientCAFile string
+	}
+
+	certReloader struct {
+		logger        *zap.Logger
+		config        TLSConfig
+		checkInterval time.Duration
+
+		mu        sync.Mutex
+		checkedAt time.Time
+		modTimes  []time.Time
+		current   *tls.Config
+	}
+
+	// serverCredentials skips the handshake of in-process connections, which never leave the process,
+	// and marks them with auth.InProcessAuthInfo so that their gateways can forward the client certificates.
+	serverCredentials struct {
+		credentials.TransportCredentials
+	}
+)
+
+// Enabled reports whether any of the files is given. A client CA file alone is an error of newServerTLSConfig
+// instead of being ignored, so that the server never starts without the client verification it is asked for.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.ClientCAFile != ""
+}
+
+// newServerTLSConfig returns the config shared by the gRPC listener and the gateways, which reloads the files.
+func newServerTLSConfig(logger *zap.Logger, config TLSConfig) (*tls.Config, error) {
+	r, err := newCertReloader(logger, config)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to newCertReloader: %w", err)
+	}
+
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: r.getConfigForClient,
+	}, nil
+}
+
+func newServerCredentials(tlsConfig *tls.Config) credentials.TransportCredentials {
+	return &serverCredentials{
+		TransportCredentials: credentials.NewTLS(tlsConfig),
+	}
+}
+
+func (c *serverCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if conn.LocalAddr().Network() == inProcessNetwork {
+		return conn, auth.InProcessAuthInfo{}, nil
+	}
+	return c.TransportCredentials.ServerHandshake(conn)
+}
+
+func (c *serverCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return c.TransportCredentials.ClientHandshake(ctx, authority, conn)
+}
+
+func (c *serverCredentials) Clone() credentials.TransportCredentials {
+	return &serverCredentials{TransportCredentials: c.TransportCredentials.Clone()}
+}
+
+func newCertReloader(logger *zap.Logger, config TLSConfig) (*certReloader, error) {
+	if config.CertFile == "" || config.KeyFile == "" {
+		return nil, xerrors.New("both cert file and key file are required for tls")
+	}
+
+	r := &certReloader{
+		logger:        logger,
+		config:        config,
+		checkInterval: certCheckInterval,
+	}
+	modTimes, err := r.stat()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to stat: %w", err)
+	}
+	current, err := r.load()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load: %w", err)
+	}
+	r.modTimes, r.current, r.checkedAt = modTimes, current, time.Now()
+	return r, nil
+}
+
+func (r *certReloader) files() []string {
+	files := []string{r.config.CertFile, r.config.KeyFile}
+	if r.config.ClientCAFile != "" {
+		files = append(files, r.config.ClientCAFile)
+	}
+	return files
+}
+
+func (r *certReloader) stat() ([]time.Time, error) {
+	files := r.files()
+	modTimes := make([]time.Time, len(files))
+	for i, f := range files {
+		fi, err := os.Stat(f)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to stat %s: %w", f, err)
+		}
+		modTimes[i] = fi.ModTime()
+	}
+	return modTimes, nil
+}
+
+func (r *certReloader) load() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(r.config.CertFile, r.config.KeyFile)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to load server certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		// gRPC uses h2, and the gateways also serve HTTP/1.1 for gRPC-Web, SSE and WebSocket clients
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+
+	if r.config.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(r.config.ClientCAFile)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read client ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, xerrors.Errorf("no certificate found in client ca file: %s", r.config.ClientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// getConfigForClient returns the latest config, reloading the files when they have changed.
+// The previous config is kept if the new files are broken, e.g. while they are being replaced.
+func (r *certReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) < r.checkInterval {
+		return r.current, nil
+	}
+	r.checkedAt = time.Now()
+
+	modTimes, err := r.stat()
+	if err != nil {
+		r.logger.Warn("failed to check tls files", zap.Error(err))
+		return r.current, nil
+	}
+	if equalTimes(modTimes, r.modTimes) {
+		return r.current, nil
+	}
+
+	current, err := r.load()
+	if err != nil {
+		r.logger.Warn("failed to reload tls files", zap.Error(err))
+		return r.current, nil
+	}
+	r.modTimes, r.current = modTimes, current
+	r.logger.Info("tls files reloaded")
+	return r.current, nil
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,216 @@
+package grpc_server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/auth"
+	"github.com/swallowarc/porker-rpc/internal/tests/testcerts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func writeServerFiles(t *testing.T, dir string, ca *testcerts.CA, serial int64, modTime time.Time) TLSConfig {
+	certPEM, keyPEM := ca.Issue(t, serial, "localhost", x509.ExtKeyUsageServerAuth)
+	return TLSConfig{
+		CertFile: testcerts.WriteFile(t, dir, "server.crt", certPEM, modTime),
+		KeyFile:  testcerts.WriteFile(t, dir, "server.key", keyPEM, modTime),
+	}
+}
+
+// runTLSServer serves the health service with the credentials and returns the identity seen by the interceptor.
+func runTLSServer(t *testing.T, config TLSConfig) (string, <-chan *auth.ClientIdentity) {
+	tlsConfig, err := newServerTLSConfig(zap.NewNop(), config)
+	if err != nil {
+		t.Fatalf("failed to newServerTLSConfig: %v", err)
+	}
+	identities := make(chan *auth.ClientIdentity, 1)
+	server := grpc.NewServer(
+		grpc.Creds(newServerCredentials(tlsConfig)),
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			id, _ := auth.ClientIdentityFromContext(ctx)
+			identities <- id
+			return handler(ctx, req)
+		}),
+	)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String(), identities
+}
+
+func check(ctx context.Context, addr string, config *tls.Config) error {
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(config)), grpc.WithBlock())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestNewServerCredentials_TLS(t *testing.T) {
+	ca := testcerts.NewCA(t)
+	addr, identities := runTLSServer(t, writeServerFiles(t, t.TempDir(), ca, 2, time.Now()))
+
+	roots := ca.Pool()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := check(ctx, addr, &tls.Config{RootCAs: roots, ServerName: "localhost"}); err != nil {
+		t.Fatalf("failed to check: %v", err)
+	}
+	if id := <-identities; id != nil {
+		t.Errorf("identity must be empty without client certificate: %+v", id)
+	}
+}
+
+func TestNewServerCredentials_MTLS(t *testing.T) {
+	ca := testcerts.NewCA(t)
+	dir := t.TempDir()
+	config := writeServerFiles(t, dir, ca, 2, time.Now())
+	config.ClientCAFile = testcerts.WriteFile(t, dir, "ca.crt", ca.PEM, time.Now())
+	addr, identities := runTLSServer(t, config)
+
+	roots := ca.Pool()
+
+	t.Run("without client certificate", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := check(ctx, addr, &tls.Config{RootCAs: roots, ServerName: "localhost"}); err == nil {
+			t.Fatal("client without certificate must be rejected")
+		}
+	})
+
+	t.Run("with client certificate", func(t *testing.T) {
+		certPEM, keyPEM := ca.Issue(t, 3, "porker-client", x509.ExtKeyUsageClientAuth)
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			t.Fatalf("failed to load client certificate: %v", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		if err := check(ctx, addr, &tls.Config{RootCAs: roots, ServerName: "localhost", Certificates: []tls.Certificate{cert}}); err != nil {
+			t.Fatalf("failed to check: %v", err)
+		}
+		id := <-identities
+		if id == nil {
+			t.Fatal("identity must be available to interceptors")
+		}
+		if id.CommonName != "porker-client" || id.SerialNumber.Int64() != 3 {
+			t.Errorf("unexpected identity: %+v", id)
+		}
+	})
+}
+
+func TestCertReloader_getConfigForClient(t *testing.T) {
+	ca := testcerts.NewCA(t)
+	dir := t.TempDir()
+	now := time.Now()
+
+	r, err := newCertReloader(zap.NewNop(), writeServerFiles(t, dir, ca, 2, now))
+	if err != nil {
+		t.Fatalf("failed to newCertReloader: %v", err)
+	}
+	r.checkInterval = 0
+
+	serial := func() int64 {
+		config, err := r.getConfigForClient(nil)
+		if err != nil {
+			t.Fatalf("failed to getConfigForClient: %v", err)
+		}
+		cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+		if err != nil {
+			t.Fatalf("failed to parse certificate: %v", err)
+		}
+		return cert.SerialNumber.Int64()
+	}
+
+	if got := serial(); got != 2 {
+		t.Fatalf("unexpected serial: %d", got)
+	}
+
+	// rotated files are picked up
+	writeServerFiles(t, dir, ca, 3, now.Add(time.Minute))
+	if got := serial(); got != 3 {
+		t.Errorf("rotated certificate must be loaded: %d", got)
+	}
+
+	// broken files keep the previous certificate
+	testcerts.WriteFile(t, dir, "server.key", []byte("broken"), now.Add(2*time.Minute))
+	if got := serial(); got != 3 {
+		t.Errorf("previous certificate must be kept: %d", got)
+	}
+}
+
+func TestNewServerTLSConfig_Errors(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name   string
+		config TLSConfig
+	}{
+		{name: "key file missing", config: TLSConfig{CertFile: filepath.Join(dir, "server.crt")}},
+		{name: "files not found", config: TLSConfig{CertFile: filepath.Join(dir, "server.crt"), KeyFile: filepath.Join(dir, "server.key")}},
+		{name: "client ca without server certificate", config: TLSConfig{ClientCAFile: filepath.Join(dir, "ca.crt")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.config.Enabled() {
+				t.Fatal("tls must be enabled by any of the files")
+			}
+			if _, err := newServerTLSConfig(zap.NewNop(), tt.config); err == nil {
+				t.Error("error must be returned")
+			}
+		})
+	}
+}
+
+func TestServerCredentials_ServerHandshake_InProcess(t *testing.T) {
+	ca := testcerts.NewCA(t)
+	tlsConfig, err := newServerTLSConfig(zap.NewNop(), writeServerFiles(t, t.TempDir(), ca, 2, time.Now()))
+	if err != nil {
+		t.Fatalf("failed to newServerTLSConfig: %v", err)
+	}
+	creds := newServerCredentials(tlsConfig)
+
+	lis := bufconn.Listen(1024)
+	defer lis.Close()
+	go func() {
+		if conn, err := lis.Dial(); err == nil {
+			defer conn.Close()
+		}
+	}()
+	conn, err := lis.Accept()
+	if err != nil {
+		t.Fatalf("failed to accept: %v", err)
+	}
+	defer conn.Close()
+
+	got, info, err := creds.ServerHandshake(conn)
+	if err != nil {
+		t.Fatalf("failed to ServerHandshake: %v", err)
+	}
+	if got != conn {
+		t.Error("in-process connections must skip the handshake")
+	}
+	if _, ok := info.(auth.InProcessAuthInfo); !ok {
+		t.Errorf("expected InProcessAuthInfo, actual %T", info)
+	}
+}
@@ -0,0 +1,11 @@
+package grpc_web
+
+// Config is
+// gRPC-Web listener settings.
+type Config struct {
+	// Enabled starts the gRPC-Web listener in front of the gRPC server, which replaces the Envoy proxy.
+	Enabled bool   `envconfig:"enabled" default:"false"`
+	Port    string `envconfig:"port" default:"8080"`
+	// AllowedOrigins are the origins allowed by CORS. "*" allows any origin.
+	AllowedOrigins []string `envconfig:"allowed_origins" default:"*"`
+}
@@ -0,0 +1,93 @@
+package grpc_web
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/grpc_server"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+)
+
+type (
+	gateway struct {
+		logger *zap.Logger
+		config Config
+		lis    net.Listener
+		server *http.Server
+		ready  chan struct{}
+	}
+)
+
+func NewGRPCWebGateway(logger *zap.Logger, config Config) grpc_server.Gateway {
+	return &gateway{
+		logger: logger,
+		config: config,
+		ready:  make(chan struct{}),
+	}
+}
+
+func (g *gateway) Serve(server *grpc.Server, tlsConfig *tls.Config) error {
+	lis, err := net.Listen("tcp", net.JoinHostPort("", g.config.Port))
+	if err != nil {
+		close(g.ready)
+		return xerrors.Errorf("failed to listen: %w", err)
+	}
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+	g.lis = lis
+	g.server = &http.Server{
+		Handler: g.handler(server),
+	}
+	close(g.ready)
+
+	g.logger.Info("gRPC-Web gateway start", zap.String("port", g.config.Port), zap.Bool("tls", tlsConfig != nil))
+	if err := g.server.Serve(lis); err != nil && err != http.ErrServerClosed {
+		return xerrors.Errorf("failed to Serve: %w", err)
+	}
+	return nil
+}
+
+func (g *gateway) handler(server *grpc.Server) http.Handler {
+	wrapped := grpcweb.WrapServer(server,
+		grpcweb.WithOriginFunc(g.allowOrigin),
+		grpcweb.WithCorsForRegisteredEndpointsOnly(true),
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrapped.IsGrpcWebRequest(r) || wrapped.IsAcceptableGrpcCorsRequest(r) {
+			wrapped.ServeHTTP(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	})
+}
+
+func (g *gateway) allowOrigin(origin string) bool {
+	for _, o := range g.config.AllowedOrigins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// Shutdown waits for the running requests until ctx is done and then closes the remaining streams.
+func (g *gateway) Shutdown(ctx context.Context) error {
+	<-g.ready
+	if g.server == nil {
+		return nil
+	}
+
+	if err := g.server.Shutdown(ctx); err != nil {
+		// EnterRoom streams do not end by themselves
+		if err := g.server.Close(); err != nil {
+			return xerrors.Errorf("failed to Close: %w", err)
+		}
+	}
+	return nil
+}
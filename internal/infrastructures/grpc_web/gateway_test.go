@@ -0,0 +1,226 @@
+package grpc_web
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/tests/testcerts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func runGateway(t *testing.T, allowedOrigins ...string) string {
+	return "http://" + serveGateway(t, nil, allowedOrigins...)
+}
+
+// serveGateway serves the health service through the gateway and returns the address.
+func serveGateway(t *testing.T, tlsConfig *tls.Config, allowedOrigins ...string) string {
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	g := NewGRPCWebGateway(zap.NewNop(), Config{Enabled: true, Port: "0", AllowedOrigins: allowedOrigins}).(*gateway)
+	go func() {
+		if err := g.Serve(server, tlsConfig); err != nil {
+			t.Errorf("failed to Serve: %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := g.Shutdown(ctx); err != nil {
+			t.Errorf("failed to Shutdown: %v", err)
+		}
+	})
+
+	<-g.ready
+	if g.lis == nil {
+		t.Fatal("gateway did not listen")
+	}
+	return g.lis.Addr().String()
+}
+
+// frame encodes msg as a gRPC-Web data frame.
+func frame(t *testing.T, msg proto.Message) []byte {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("failed to Marshal: %v", err)
+	}
+	header := make([]byte, 5)
+	binary.BigEndian.PutUint32(header[1:], uint32(len(b)))
+	return append(header, b...)
+}
+
+// readFrame returns the flag and the payload of the next gRPC-Web frame.
+func readFrame(t *testing.T, r io.Reader) (byte, []byte) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(r, header); err != nil {
+		t.Fatalf("failed to read frame header: %v", err)
+	}
+	payload := make([]byte, binary.BigEndian.Uint32(header[1:]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		t.Fatalf("failed to read frame payload: %v", err)
+	}
+	return header[0], payload
+}
+
+func post(t *testing.T, url string, msg proto.Message) *http.Response {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(frame(t, msg)))
+	if err != nil {
+		t.Fatalf("failed to NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("X-Grpc-Web", "1")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to Do: %v", err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected %d, actual %d", http.StatusOK, resp.StatusCode)
+	}
+	return resp
+}
+
+func TestGateway_Unary(t *testing.T) {
+	url := runGateway(t, "*")
+	resp := post(t, url+"/grpc.health.v1.Health/Check", &healthpb.HealthCheckRequest{})
+	r := bufio.NewReader(resp.Body)
+
+	_, payload := readFrame(t, r)
+	res := &healthpb.HealthCheckResponse{}
+	if err := proto.Unmarshal(payload, res); err != nil {
+		t.Fatalf("failed to Unmarshal: %v", err)
+	}
+	if res.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("expected %s, actual %s", healthpb.HealthCheckResponse_SERVING, res.Status)
+	}
+
+	flag, trailer := readFrame(t, r)
+	if flag&0x80 == 0 || !bytes.Contains(trailer, []byte("grpc-status: 0")) {
+		t.Errorf("expected OK trailer, actual flag: %x, trailer: %s", flag, trailer)
+	}
+}
+
+func TestGateway_ServerStreaming(t *testing.T) {
+	url := runGateway(t, "*")
+	resp := post(t, url+"/grpc.health.v1.Health/Watch", &healthpb.HealthCheckRequest{})
+
+	// Watch keeps the stream open, so the first message must be flushed before the stream ends
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, payload := readFrame(t, resp.Body)
+		res := &healthpb.HealthCheckResponse{}
+		if err := proto.Unmarshal(payload, res); err != nil {
+			t.Errorf("failed to Unmarshal: %v", err)
+		}
+		if res.Status != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("expected %s, actual %s", healthpb.HealthCheckResponse_SERVING, res.Status)
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("streamed message was not received")
+	}
+}
+
+func TestGateway_CORS(t *testing.T) {
+	url := runGateway(t, "https://porker.example.com")
+
+	for origin, allowed := range map[string]bool{
+		"https://porker.example.com": true,
+		"https://evil.example.com":   false,
+	} {
+		req, err := http.NewRequest(http.MethodOptions, url+"/grpc.health.v1.Health/Check", nil)
+		if err != nil {
+			t.Fatalf("failed to NewRequest: %v", err)
+		}
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web,porker-login-id,porker-session-id")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to Do: %v", err)
+		}
+		_ = resp.Body.Close()
+
+		if actual := resp.Header.Get("Access-Control-Allow-Origin") == origin; actual != allowed {
+			t.Errorf("%s: expected allowed %v, actual %v", origin, allowed, actual)
+		}
+	}
+}
+
+func TestGateway_NotGRPCWeb(t *testing.T) {
+	url := runGateway(t, "*")
+
+	resp, err := http.Get(url + "/")
+	if err != nil {
+		t.Fatalf("failed to Get: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected %d, actual %d", http.StatusNotFound, resp.StatusCode)
+	}
+}
+
+func TestGateway_MTLS(t *testing.T) {
+	ca := testcerts.NewCA(t)
+	serverCert, err := tls.X509KeyPair(ca.Issue(t, 2, "localhost", x509.ExtKeyUsageServerAuth))
+	if err != nil {
+		t.Fatalf("failed to load server certificate: %v", err)
+	}
+	clientCert, err := tls.X509KeyPair(ca.Issue(t, 3, "porker-client", x509.ExtKeyUsageClientAuth))
+	if err != nil {
+		t.Fatalf("failed to load client certificate: %v", err)
+	}
+	url := "https://" + serveGateway(t, &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    ca.Pool(),
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}, "*")
+
+	check := func(certs []tls.Certificate, h2 bool) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodPost, url+"/grpc.health.v1.Health/Check", bytes.NewReader(frame(t, &healthpb.HealthCheckRequest{})))
+		if err != nil {
+			t.Fatalf("failed to NewRequest: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/grpc-web+proto")
+		req.Header.Set("X-Grpc-Web", "1")
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{RootCAs: ca.Pool(), ServerName: "localhost", Certificates: certs},
+			ForceAttemptHTTP2: h2,
+		}}
+		resp, err := client.Do(req)
+		if err == nil {
+			t.Cleanup(func() { _ = resp.Body.Close() })
+		}
+		return resp, err
+	}
+
+	if _, err := check(nil, false); err == nil {
+		t.Error("client without certificate must be rejected")
+	}
+	for _, h2 := range []bool{false, true} {
+		resp, err := check([]tls.Certificate{clientCert}, h2)
+		if err != nil {
+			t.Fatalf("failed to Do (h2: %v): %v", h2, err)
+		}
+		if resp.StatusCode != http.StatusOK || (resp.ProtoMajor == 2) != h2 {
+			t.Errorf("expected %d over h2 %v, actual %d %s", http.StatusOK, h2, resp.StatusCode, resp.Proto)
+		}
+	}
+}
@@ -0,0 +1,493 @@
+package jetstream
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/memdb"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+)
+
+const (
+	streamName    = "PORKER_ROOMS"
+	subjectPrefix = "porker.rooms."
+	// expiryBucketPrefix is followed by the TTL in milliseconds, because the TTL of a bucket is shared by its keys.
+	expiryBucketPrefix = "PORKER_ROOM_EXPIRIES_"
+	// expiryMarkerTTL is how long the markers of expired keys are kept,
+	// so that instances started in the meantime also purge the subjects.
+	expiryMarkerTTL = time.Hour
+
+	subscribeDuration = 3 * time.Second
+	readyTimeout      = 10 * time.Second
+	requestTimeout    = 5 * time.Second
+)
+
+type (
+	// jetStreamClient is a StreamClient backed by a NATS server embedded in the process.
+	// Each stream key is a subject of one JetStream stream, and the stream sequence is used as the message ID.
+	// Readers are woken up by the core NATS delivery of the subject, which also reaches other instances through routes.
+	jetStreamClient struct {
+		server    *server.Server
+		nc        *nats.Conn
+		js        jetstream.JetStream
+		stream    jetstream.Stream
+		config    Config
+		retention gateways.StreamRetention
+
+		// ctx is canceled on Close to stop the watchers of the expiry buckets.
+		ctx    context.Context
+		cancel context.CancelFunc
+		wg     sync.WaitGroup
+
+		mu      sync.Mutex
+		buckets map[time.Duration]jetstream.KeyValue
+	}
+)
+
+// NewJetStreamClient starts the embedded NATS server and creates the room stream if it does not exist.
+func NewJetStreamClient(config Config, retention gateways.StreamRetention) (gateways.StreamClient, error) {
+	s, err := server.NewServer(serverOptions(config))
+	if err != nil {
+		return nil, xerrors.Errorf("failed to create nats server: %w", err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(readyTimeout) {
+		s.Shutdown()
+		return nil, xerrors.New("nats server is not ready for connections")
+	}
+
+	nc, err := nats.Connect(s.ClientURL())
+	if err != nil {
+		s.Shutdown()
+		return nil, xerrors.Errorf("failed to connect to nats server: %w", err)
+	}
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		s.Shutdown()
+		return nil, xerrors.Errorf("failed to get jetstream context: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &jetStreamClient{
+		server:    s,
+		nc:        nc,
+		js:        js,
+		config:    config,
+		retention: retention,
+		ctx:       ctx,
+		cancel:    cancel,
+		buckets:   map[time.Duration]jetstream.KeyValue{},
+	}
+	if err := c.ensureStream(); err != nil {
+		_ = c.Close()
+		return nil, xerrors.Errorf("failed to ensureStream: %w", err)
+	}
+
+	return c, nil
+}
+
+func serverOptions(config Config) *server.Options {
+	opts := &server.Options{
+		ServerName: config.ServerName,
+		Host:       "127.0.0.1",
+		Port:       server.RANDOM_PORT,
+		NoSigs:     true,
+		JetStream:  true,
+		StoreDir:   config.StoreDir,
+	}
+	if config.ClusterPort > 0 {
+		opts.Cluster = server.ClusterOpts{
+			Name: config.ClusterName,
+			Host: config.ClusterHost,
+			Port: config.ClusterPort,
+		}
+		opts.Routes = server.RoutesFromStr(strings.Join(config.Routes, ","))
+	}
+	return opts
+}
+
+func (c *jetStreamClient) ensureStream() error {
+	ctx, cancel := context.WithTimeout(c.ctx, requestTimeout)
+	defer cancel()
+
+	stream, err := c.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:              streamName,
+		Subjects:          []string{subjectPrefix + ">"},
+		Storage:           jetstream.FileStorage,
+		MaxMsgsPerSubject: c.retention.MaxLen,
+		MaxAge:            c.retention.MaxAge,
+		Replicas:          c.config.Replicas,
+	})
+	if err != nil {
+		return xerrors.Errorf("failed to CreateOrUpdateStream: %w", err)
+	}
+	c.stream = stream
+	return nil
+}
+
+func (c *jetStreamClient) Close() error {
+	c.cancel()
+	c.wg.Wait()
+
+	c.nc.Close()
+	c.server.Shutdown()
+	c.server.WaitForShutdown()
+	return nil
+}
+
+func (c *jetStreamClient) PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) error {
+	if err := c.publish(ctx, streamKey, messages); err != nil {
+		return xerrors.Errorf("failed to jetstream PublishStream: %w", err)
+	}
+	return nil
+}
+
+func (c *jetStreamClient) PublishStreamIfLatest(ctx context.Context, streamKey, latestID string, messages map[string]interface{}) error {
+	var expected uint64
+	if latestID != "" {
+		seq, err := strconv.ParseUint(latestID, 10, 64)
+		if err != nil {
+			return xerrors.Errorf("failed to jetstream PublishStreamIfLatest. invalid message id: %s", latestID)
+		}
+		expected = seq
+	}
+
+	// 0 means the subject must be empty
+	if err := c.publish(ctx, streamKey, messages, jetstream.WithExpectLastSequencePerSubject(expected)); err != nil {
+		var apiErr *jetstream.APIError
+		if xerrors.As(err, &apiErr) && apiErr.ErrorCode == jetstream.JSErrCodeStreamWrongLastSequence {
+			return errs.NewConflictError(fmt.Sprintf("stream has been updated. streamKey: %s, latestID: %s", streamKey, latestID))
+		}
+		return xerrors.Errorf("failed to jetstream PublishStreamIfLatest: %w", err)
+	}
+	return nil
+}
+
+func (c *jetStreamClient) publish(ctx context.Context, streamKey string, messages map[string]interface{}, opts ...jetstream.PublishOpt) error {
+	data, err := memdb.EncodeMessages(messages)
+	if err != nil {
+		return err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	if _, err := c.js.PublishMsg(reqCtx, &nats.Msg{Subject: subject(streamKey), Data: data}, opts...); err != nil {
+		return xerrors.Errorf("failed to PublishMsg: %w", err)
+	}
+	return nil
+}
+
+func (c *jetStreamClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error) {
+	prev, err := strconv.ParseUint(previousID, 10, 64)
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to jetstream ReadStream. invalid message id: %s, streamKey: %s", previousID, streamKey)
+	}
+
+	// 読み込み前に購読しておき、読み込み後に publish されたメッセージも取りこぼさない
+	sub, err := c.nc.SubscribeSync(subject(streamKey))
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to jetstream ReadStream. err: %w, streamKey: %s", err, streamKey)
+	}
+	defer func() {
+		_ = sub.Unsubscribe()
+	}()
+
+	waitCtx, cancel := context.WithTimeout(ctx, subscribeDuration)
+	defer cancel()
+
+	for {
+		msg, err := c.latest(ctx, streamKey)
+		if err != nil {
+			return "", "", xerrors.Errorf("failed to jetstream ReadStream. err: %w, streamKey: %s, messageID: %s", err, streamKey, previousID)
+		}
+		if msg != nil && msg.Sequence > prev {
+			return pickMessage(ctx, msg, messageKey)
+		}
+
+		if _, err := sub.NextMsgWithContext(waitCtx); err != nil {
+			if ctx.Err() != nil {
+				return "", "", xerrors.Errorf("failed to jetstream ReadStream. err: %w, streamKey: %s, messageID: %s", ctx.Err(), streamKey, previousID)
+			}
+			if waitCtx.Err() != nil {
+				return "", "", errs.NewNotFoundError("response nil from stream")
+			}
+			return "", "", xerrors.Errorf("failed to jetstream ReadStream. err: %w, streamKey: %s, messageID: %s", err, streamKey, previousID)
+		}
+	}
+}
+
+func (c *jetStreamClient) ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (id, message string, err error) {
+	msg, err := c.latest(ctx, streamKey)
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to jetstream ReadStreamLatest: %w", err)
+	}
+	if msg == nil {
+		return "", "", errs.NewNotFoundError(fmt.Sprintf("%s does not exist", streamKey))
+	}
+	return pickMessage(ctx, msg, messageKey)
+}
+
+// latest returns the last message of the subject, or nil if the subject has no message.
+func (c *jetStreamClient) latest(ctx context.Context, streamKey string) (*jetstream.RawStreamMsg, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	msg, err := c.stream.GetLastMsgForSubject(reqCtx, subject(streamKey))
+	if xerrors.Is(err, jetstream.ErrMsgNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("failed to GetLastMsgForSubject: %w", err)
+	}
+	return msg, nil
+}
+
+// ReadStreamRange gets the messages of the subject one by one, so that no consumer is left on the server.
+func (c *jetStreamClient) ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]gateways.StreamMessage, error) {
+	last, err := c.latest(ctx, streamKey)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to jetstream ReadStreamRange: %w", err)
+	}
+	result := []gateways.StreamMessage{}
+	if last == nil {
+		return result, nil
+	}
+
+	var minTime time.Time
+	if c.retention.MaxAge > 0 {
+		minTime = time.Now().Add(-c.retention.MaxAge)
+	}
+	for seq := uint64(1); seq <= last.Sequence; {
+		msg, err := c.next(ctx, streamKey, seq)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to jetstream ReadStreamRange: %w", err)
+		}
+		if msg == nil {
+			break
+		}
+		seq = msg.Sequence + 1
+
+		if msg.Sequence != last.Sequence && msg.Time.Before(minTime) {
+			continue
+		}
+		values, err := memdb.DecodeMessages(msg.Data)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to jetstream ReadStreamRange: %w", err)
+		}
+		v, ok := values[messageKey]
+		if !ok {
+			loggers.Logger(ctx).Warn("message key does not exist in stream message", zap.Reflect("message", values))
+			continue
+		}
+		result = append(result, gateways.StreamMessage{
+			ID:          strconv.FormatUint(msg.Sequence, 10),
+			Message:     v,
+			PublishedAt: msg.Time,
+		})
+	}
+	return result, nil
+}
+
+// next returns the first message of the subject from seq, or nil if there is none.
+func (c *jetStreamClient) next(ctx context.Context, streamKey string, seq uint64) (*jetstream.RawStreamMsg, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	msg, err := c.stream.GetMsg(reqCtx, seq, jetstream.WithGetMsgSubject(subject(streamKey)))
+	if xerrors.Is(err, jetstream.ErrMsgNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("failed to GetMsg: %w", err)
+	}
+	return msg, nil
+}
+
+// Expire purges the subject when it has not been expired again for duration.
+// The deadline is a key of the KV bucket whose TTL is duration, which is shared by the instances through the cluster,
+// and every instance purges the subject when the bucket marks the key as expired.
+// A stream key must always be expired with the same duration.
+func (c *jetStreamClient) Expire(ctx context.Context, streamKey string, duration time.Duration) error {
+	kv, err := c.bucket(ctx, duration)
+	if err != nil {
+		return xerrors.Errorf("failed to jetstream Expire: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	if _, err := kv.Put(reqCtx, expiryKey(streamKey), nil); err != nil {
+		return xerrors.Errorf("failed to jetstream Expire. failed to Put: %w", err)
+	}
+	return nil
+}
+
+// bucket returns the expiry bucket of duration, and starts watching it when it is used first in the process.
+func (c *jetStreamClient) bucket(ctx context.Context, duration time.Duration) (jetstream.KeyValue, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if kv, ok := c.buckets[duration]; ok {
+		return kv, nil
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	kv, err := c.js.CreateOrUpdateKeyValue(reqCtx, jetstream.KeyValueConfig{
+		Bucket:         expiryBucketPrefix + strconv.FormatInt(duration.Milliseconds(), 10),
+		TTL:            duration,
+		LimitMarkerTTL: expiryMarkerTTL,
+		Storage:        jetstream.FileStorage,
+		Replicas:       c.config.Replicas,
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("failed to CreateOrUpdateKeyValue: %w", err)
+	}
+	// 他のインスタンスで期限を延長したキーも見るため、ここで作った watcher は Close まで止めない
+	w, err := kv.WatchAll(c.ctx, jetstream.MetaOnly())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to WatchAll: %w", err)
+	}
+
+	c.buckets[duration] = kv
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.watchExpiries(kv, w)
+	}()
+	return kv, nil
+}
+
+// watchExpiries purges the subjects of the keys that the bucket has expired until the client is closed.
+func (c *jetStreamClient) watchExpiries(kv jetstream.KeyValue, w jetstream.KeyWatcher) {
+	defer func() {
+		_ = w.Stop()
+	}()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case entry, ok := <-w.Updates():
+			if !ok {
+				return
+			}
+			// nil marks the end of the initial values. Deleted keys are not expired but removed by Del.
+			if entry == nil || entry.Operation() != jetstream.KeyValuePurge {
+				continue
+			}
+			streamKey, err := fromExpiryKey(entry.Key())
+			if err != nil {
+				loggers.Logger(c.ctx).Warn("unknown key in jetstream expiry bucket", zap.String("key", entry.Key()))
+				continue
+			}
+			if err := c.expire(kv, streamKey); err != nil && c.ctx.Err() == nil {
+				loggers.Logger(c.ctx).Warn("failed to expire jetstream subject", zap.String("stream_key", streamKey), zap.Error(err))
+			}
+		}
+	}
+}
+
+// expire purges the subject unless the key has been expired again since the bucket marked it as expired.
+func (c *jetStreamClient) expire(kv jetstream.KeyValue, streamKey string) error {
+	ctx, cancel := context.WithTimeout(c.ctx, requestTimeout)
+	defer cancel()
+
+	_, err := kv.Get(ctx, expiryKey(streamKey))
+	if err == nil {
+		return nil
+	}
+	if !xerrors.Is(err, jetstream.ErrKeyNotFound) {
+		return xerrors.Errorf("failed to Get: %w", err)
+	}
+
+	last, err := c.latest(ctx, streamKey)
+	if err != nil || last == nil {
+		return err
+	}
+	// 確認後に publish されたメッセージは残す
+	return c.purge(ctx, streamKey, last.Sequence+1)
+}
+
+func (c *jetStreamClient) Del(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	buckets := make([]jetstream.KeyValue, 0, len(c.buckets))
+	for _, kv := range c.buckets {
+		buckets = append(buckets, kv)
+	}
+	c.mu.Unlock()
+
+	for _, key := range keys {
+		// 削除は期限切れとして扱われず、watcher からは purge されない
+		for _, kv := range buckets {
+			if err := kv.Delete(ctx, expiryKey(key)); err != nil {
+				return xerrors.Errorf("failed to jetstream Del. failed to Delete expiry: %w", err)
+			}
+		}
+		if err := c.purge(ctx, key, 0); err != nil {
+			return xerrors.Errorf("failed to jetstream Del: %w", err)
+		}
+	}
+	return nil
+}
+
+// purge removes the messages of the subject before seq, or all of them when seq is 0.
+func (c *jetStreamClient) purge(ctx context.Context, streamKey string, seq uint64) error {
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	opts := []jetstream.StreamPurgeOpt{jetstream.WithPurgeSubject(subject(streamKey))}
+	if seq > 0 {
+		opts = append(opts, jetstream.WithPurgeSequence(seq))
+	}
+	if err := c.stream.Purge(reqCtx, opts...); err != nil {
+		return xerrors.Errorf("failed to Purge: %w", err)
+	}
+	return nil
+}
+
+// subject converts a stream key to a subject. "." separates tokens of a subject, so it can not be used in a key.
+func subject(streamKey string) string {
+	return subjectPrefix + strings.ReplaceAll(streamKey, ".", "_")
+}
+
+// expiryKey converts a stream key to a key of the expiry buckets, which does not allow the characters of the room keys such as "{".
+func expiryKey(streamKey string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(streamKey))
+}
+
+func fromExpiryKey(key string) (string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(key)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func pickMessage(ctx context.Context, msg *jetstream.RawStreamMsg, messageKey string) (id, message string, err error) {
+	values, err := memdb.DecodeMessages(msg.Data)
+	if err != nil {
+		return "", "", err
+	}
+	v, ok := values[messageKey]
+	if !ok {
+		loggers.Logger(ctx).Warn("message key does not exist in stream message", zap.Reflect("message", values))
+		return "", "", nil
+	}
+	return strconv.FormatUint(msg.Sequence, 10), v, nil
+}
@@ -0,0 +1,196 @@
+package jetstream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+)
+
+func newTestClient(t *testing.T, retention gateways.StreamRetention) gateways.StreamClient {
+	cli, err := NewJetStreamClient(Config{StoreDir: t.TempDir(), Replicas: 1}, retention)
+	if err != nil {
+		t.Fatalf("failed to NewJetStreamClient: %v", err)
+	}
+	t.Cleanup(func() { _ = cli.(*jetStreamClient).Close() })
+	return cli
+}
+
+func TestJetStreamClient_Stream(t *testing.T) {
+	ctx := context.Background()
+	cli := newTestClient(t, gateways.StreamRetention{MaxLen: 1})
+
+	if _, _, err := cli.ReadStreamLatest(ctx, "porker_room_stream:{11111}", "msg"); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, actual %v", err)
+	}
+	if err := cli.PublishStream(ctx, "porker_room_stream:{11111}", map[string]interface{}{"msg": []byte("first\xff")}); err != nil {
+		t.Fatalf("failed to PublishStream: %v", err)
+	}
+
+	id, msg, err := cli.ReadStreamLatest(ctx, "porker_room_stream:{11111}", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if msg != "first\xff" {
+		t.Errorf("expected %q, actual %q", "first\xff", msg)
+	}
+
+	type result struct {
+		id, msg string
+		err     error
+	}
+	ch := make(chan result)
+	go func() {
+		id, msg, err := cli.ReadStream(ctx, "porker_room_stream:{11111}", "msg", id)
+		ch <- result{id: id, msg: msg, err: err}
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	// 別の subject への publish では起こされない
+	if err := cli.PublishStream(ctx, "porker_room_stream:{22222}", map[string]interface{}{"msg": "other"}); err != nil {
+		t.Fatalf("failed to PublishStream: %v", err)
+	}
+	if err := cli.PublishStream(ctx, "porker_room_stream:{11111}", map[string]interface{}{"msg": "second"}); err != nil {
+		t.Fatalf("failed to PublishStream: %v", err)
+	}
+
+	select {
+	case r := <-ch:
+		if r.err != nil {
+			t.Fatalf("failed to ReadStream: %v", r.err)
+		}
+		if r.msg != "second" || r.id == id {
+			t.Errorf("expected new message, actual id: %s, msg: %s", r.id, r.msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("ReadStream was not woken up by PublishStream")
+	}
+}
+
+func TestJetStreamClient_ReadStreamTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cli := newTestClient(t, gateways.StreamRetention{MaxLen: 1})
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	_, _, err := cli.ReadStream(ctx, "stream", "msg", "0")
+	if err == nil || errs.IsNotFoundError(err) {
+		t.Errorf("expected error by context cancel, actual %v", err)
+	}
+}
+
+func TestJetStreamClient_PublishStreamIfLatest(t *testing.T) {
+	ctx := context.Background()
+	cli := newTestClient(t, gateways.StreamRetention{MaxLen: 1})
+
+	if err := cli.PublishStreamIfLatest(ctx, "stream", "", map[string]interface{}{"msg": "first"}); err != nil {
+		t.Fatalf("failed to PublishStreamIfLatest to empty stream: %v", err)
+	}
+	if err := cli.PublishStreamIfLatest(ctx, "stream", "", map[string]interface{}{"msg": "again"}); !errs.IsConflictError(err) {
+		t.Errorf("expected ConflictError, actual %v", err)
+	}
+	id, _, err := cli.ReadStreamLatest(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+
+	if err := cli.PublishStreamIfLatest(ctx, "stream", id, map[string]interface{}{"msg": "second"}); err != nil {
+		t.Fatalf("failed to PublishStreamIfLatest: %v", err)
+	}
+	if err := cli.PublishStreamIfLatest(ctx, "stream", id, map[string]interface{}{"msg": "third"}); !errs.IsConflictError(err) {
+		t.Errorf("expected ConflictError, actual %v", err)
+	}
+
+	if _, msg, _ := cli.ReadStreamLatest(ctx, "stream", "msg"); msg != "second" {
+		t.Errorf("expected %s, actual %s", "second", msg)
+	}
+}
+
+func TestJetStreamClient_RangeAndDel(t *testing.T) {
+	ctx := context.Background()
+	cli := newTestClient(t, gateways.StreamRetention{MaxLen: 3})
+
+	for _, m := range []string{"1", "2", "3", "4"} {
+		if err := cli.PublishStream(ctx, "stream", map[string]interface{}{"msg": m}); err != nil {
+			t.Fatalf("failed to PublishStream: %v", err)
+		}
+		if err := cli.PublishStream(ctx, "other", map[string]interface{}{"msg": m}); err != nil {
+			t.Fatalf("failed to PublishStream: %v", err)
+		}
+	}
+
+	msgs, err := cli.ReadStreamRange(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamRange: %v", err)
+	}
+	if len(msgs) != 3 || msgs[0].Message != "2" || msgs[2].Message != "4" {
+		t.Errorf("expected last 3 messages in order, actual %v", msgs)
+	}
+	info, err := cli.(*jetStreamClient).stream.Info(ctx)
+	if err != nil {
+		t.Fatalf("failed to get stream info: %v", err)
+	}
+	if info.State.Consumers != 0 {
+		t.Errorf("ReadStreamRange must not leave consumers, actual %d", info.State.Consumers)
+	}
+
+	if err := cli.Del(ctx, "stream"); err != nil {
+		t.Fatalf("failed to Del: %v", err)
+	}
+	if msgs, err := cli.ReadStreamRange(ctx, "stream", "msg"); err != nil || len(msgs) != 0 {
+		t.Errorf("expected no message, actual %v, err: %v", msgs, err)
+	}
+	if _, msg, err := cli.ReadStreamLatest(ctx, "other", "msg"); err != nil || msg != "4" {
+		t.Errorf("expected %s, actual %s, err: %v", "4", msg, err)
+	}
+}
+
+func TestJetStreamClient_Expire(t *testing.T) {
+	ctx := context.Background()
+	cli := newTestClient(t, gateways.StreamRetention{MaxLen: 3})
+
+	for _, key := range []string{"stream", "other"} {
+		if err := cli.PublishStream(ctx, key, map[string]interface{}{"msg": "first"}); err != nil {
+			t.Fatalf("failed to PublishStream: %v", err)
+		}
+		if err := cli.Expire(ctx, key, time.Second); err != nil {
+			t.Fatalf("failed to Expire: %v", err)
+		}
+	}
+
+	// 期限を延長すれば、その時刻から数え直す
+	time.Sleep(600 * time.Millisecond)
+	if err := cli.PublishStream(ctx, "other", map[string]interface{}{"msg": "second"}); err != nil {
+		t.Fatalf("failed to PublishStream: %v", err)
+	}
+	if err := cli.Expire(ctx, "other", time.Second); err != nil {
+		t.Fatalf("failed to Expire: %v", err)
+	}
+
+	waitNotFound(t, cli, "stream")
+	if _, msg, err := cli.ReadStreamLatest(ctx, "other", "msg"); err != nil || msg != "second" {
+		t.Errorf("expected %s, actual %s, err: %v", "second", msg, err)
+	}
+	waitNotFound(t, cli, "other")
+}
+
+// waitNotFound waits for the stream to be purged by the server, which checks the TTL of the keys periodically.
+func waitNotFound(t *testing.T, cli gateways.StreamClient, streamKey string) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		_, _, err := cli.ReadStreamLatest(context.Background(), streamKey, "msg")
+		if errs.IsNotFoundError(err) {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected NotFoundError for expired stream %s, actual %v", streamKey, err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
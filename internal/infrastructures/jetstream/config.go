@@ -0,0 +1,16 @@
+package jetstream
+
+// Config is
+// embedded NATS JetStream settings.
+type Config struct {
+	// StoreDir is the directory where JetStream keeps the room streams.
+	StoreDir   string `envconfig:"store_dir" default:"porker-nats"`
+	ServerName string `envconfig:"server_name"`
+	// ClusterName, ClusterPort and Routes connect the embedded servers of the instances.
+	// The cluster is disabled when ClusterPort is 0.
+	ClusterName string   `envconfig:"cluster_name" default:"porker"`
+	ClusterHost string   `envconfig:"cluster_host" default:"0.0.0.0"`
+	ClusterPort int      `envconfig:"cluster_port" default:"0"`
+	Routes      []string `envconfig:"routes"`
+	Replicas    int      `envconfig:"replicas" default:"1"`
+}
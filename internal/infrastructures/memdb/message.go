@@ -0,0 +1,32 @@
+package memdb
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"golang.org/x/xerrors"
+)
+
+// EncodeMessages serializes stream messages for the stores that keep a message as one binary value.
+func EncodeMessages(messages map[string]interface{}) ([]byte, error) {
+	values, err := StringifyMessages(messages)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(values); err != nil {
+		return nil, xerrors.Errorf("failed to encode stream message: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func DecodeMessages(b []byte) (map[string]string, error) {
+	var values map[string]string
+	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&values); err != nil {
+		return nil, xerrors.Errorf("failed to decode stream message: %w", err)
+	}
+	if values == nil {
+		values = map[string]string{}
+	}
+	return values, nil
+}
@@ -0,0 +1,85 @@
+package memdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	// StreamIDSize is the length of the binary form of StreamID.
+	StreamIDSize = 16
+)
+
+type (
+	// StreamID has the same "<milliseconds>-<sequence>" form as a redis stream entry ID.
+	StreamID struct {
+		MS  uint64
+		Seq uint64
+	}
+)
+
+func ParseStreamID(s string) (StreamID, error) {
+	msPart, seqPart := s, "0"
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		msPart, seqPart = s[:i], s[i+1:]
+	}
+
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return StreamID{}, xerrors.Errorf("invalid stream id: %s", s)
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return StreamID{}, xerrors.Errorf("invalid stream id: %s", s)
+	}
+	return StreamID{MS: ms, Seq: seq}, nil
+}
+
+// StreamIDFromBytes decodes the binary form made by Bytes.
+func StreamIDFromBytes(b []byte) StreamID {
+	return StreamID{
+		MS:  binary.BigEndian.Uint64(b[:8]),
+		Seq: binary.BigEndian.Uint64(b[8:StreamIDSize]),
+	}
+}
+
+// MinStreamID returns the smallest ID created at t.
+func MinStreamID(t time.Time) StreamID {
+	return StreamID{MS: uint64(t.UnixNano() / int64(time.Millisecond))}
+}
+
+// Next returns an ID that is always greater than id, even if the clock goes backwards.
+func (id StreamID) Next(now time.Time) StreamID {
+	if min := MinStreamID(now); min.MS > id.MS {
+		return min
+	}
+	return StreamID{MS: id.MS, Seq: id.Seq + 1}
+}
+
+func (id StreamID) Less(other StreamID) bool {
+	if id.MS != other.MS {
+		return id.MS < other.MS
+	}
+	return id.Seq < other.Seq
+}
+
+func (id StreamID) Time() time.Time {
+	return time.Unix(0, int64(id.MS)*int64(time.Millisecond))
+}
+
+// Bytes returns the big endian form of id, which sorts in the same order as the IDs.
+func (id StreamID) Bytes() []byte {
+	b := make([]byte, StreamIDSize)
+	binary.BigEndian.PutUint64(b[:8], id.MS)
+	binary.BigEndian.PutUint64(b[8:], id.Seq)
+	return b
+}
+
+func (id StreamID) String() string {
+	return fmt.Sprintf("%d-%d", id.MS, id.Seq)
+}
@@ -0,0 +1,72 @@
+package memdb
+
+import (
+	"encoding"
+	"strconv"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+func StringifyMessages(messages map[string]interface{}) (map[string]string, error) {
+	values := make(map[string]string, len(messages))
+	for k, v := range messages {
+		s, err := Stringify(v)
+		if err != nil {
+			return nil, err
+		}
+		values[k] = s
+	}
+	return values, nil
+}
+
+// Stringify converts a value in the same way as the redis client does when writing arguments.
+func Stringify(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case int:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int8:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int16:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int32:
+		return strconv.FormatInt(int64(v), 10), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case uint:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint8:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint16:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint32:
+		return strconv.FormatUint(uint64(v), 10), nil
+	case uint64:
+		return strconv.FormatUint(v, 10), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 64), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		if v {
+			return "1", nil
+		}
+		return "0", nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case encoding.BinaryMarshaler:
+		b, err := v.MarshalBinary()
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	default:
+		return "", xerrors.Errorf("can't marshal %T (implement encoding.BinaryMarshaler)", v)
+	}
+}
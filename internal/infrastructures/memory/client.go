@@ -0,0 +1,380 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/memdb"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+)
+
+const (
+	subscribeDuration = 3 * time.Second
+	sweepInterval     = time.Minute
+)
+
+type (
+	streamMessage struct {
+		id     memdb.StreamID
+		values map[string]string
+	}
+
+	// memoryClient is a pure-Go MemDBClient that keeps all data in process memory.
+	// It mirrors the semantics of the redis client so it can be swapped in for single node deployments and tests.
+	memoryClient struct {
+		mu        sync.Mutex
+		now       func() time.Time
+		retention gateways.StreamRetention
+		values    map[string]string
+		sets      map[string]map[string]struct{}
+		streams   map[string][]streamMessage
+		expires   map[string]time.Time
+		lastID    memdb.StreamID
+		notify    chan struct{}
+		nextSweep time.Time
+	}
+)
+
+func NewMemoryClient(retention gateways.StreamRetention) gateways.MemDBClient {
+	return newMemoryClient(time.Now, retention)
+}
+
+func newMemoryClient(now func() time.Time, retention gateways.StreamRetention) *memoryClient {
+	return &memoryClient{
+		now:       now,
+		retention: retention,
+		values:    map[string]string{},
+		sets:      map[string]map[string]struct{}{},
+		streams:   map[string][]streamMessage{},
+		expires:   map[string]time.Time{},
+		notify:    make(chan struct{}),
+	}
+}
+
+func (c *memoryClient) Ping(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return xerrors.Errorf("failed to memory Ping: %w", err)
+	}
+	return nil
+}
+
+func (c *memoryClient) Close() error {
+	return nil
+}
+
+func (c *memoryClient) Set(_ context.Context, key string, value interface{}, duration time.Duration) error {
+	v, err := memdb.Stringify(value)
+	if err != nil {
+		return xerrors.Errorf("failed to memory Set: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sweep()
+	c.delete(key)
+	c.values[key] = v
+	c.setExpire(key, duration)
+	return nil
+}
+
+func (c *memoryClient) SetNX(_ context.Context, key string, value interface{}, duration time.Duration) error {
+	v, err := memdb.Stringify(value)
+	if err != nil {
+		return xerrors.Errorf("failed to memory SetNX: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sweep()
+	if c.exists(key) {
+		return nil
+	}
+	c.values[key] = v
+	c.setExpire(key, duration)
+	return nil
+}
+
+func (c *memoryClient) Get(_ context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expireIfNeeded(key)
+	val, ok := c.values[key]
+	if !ok {
+		return "", errs.NewNotFoundError(fmt.Sprintf("%s does not exist", key))
+	}
+	return val, nil
+}
+
+func (c *memoryClient) Del(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		c.delete(key)
+	}
+	return nil
+}
+
+func (c *memoryClient) SAdd(_ context.Context, key string, values ...interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sweep()
+	c.expireIfNeeded(key)
+	set, ok := c.sets[key]
+	if !ok {
+		set = map[string]struct{}{}
+	}
+	for _, value := range values {
+		v, err := memdb.Stringify(value)
+		if err != nil {
+			return xerrors.Errorf("failed to memory SAdd: %w", err)
+		}
+		set[v] = struct{}{}
+	}
+	c.sets[key] = set
+	return nil
+}
+
+func (c *memoryClient) SRem(_ context.Context, key string, members ...interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expireIfNeeded(key)
+	set, ok := c.sets[key]
+	if !ok {
+		return nil
+	}
+	for _, member := range members {
+		m, err := memdb.Stringify(member)
+		if err != nil {
+			return xerrors.Errorf("failed to memory SRem: %w", err)
+		}
+		delete(set, m)
+	}
+	if len(set) == 0 {
+		c.delete(key)
+	}
+	return nil
+}
+
+func (c *memoryClient) SMembers(_ context.Context, key string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expireIfNeeded(key)
+	set := c.sets[key]
+	members := make([]string, 0, len(set))
+	for m := range set {
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+func (c *memoryClient) PublishStream(_ context.Context, streamKey string, messages map[string]interface{}) error {
+	values, err := memdb.StringifyMessages(messages)
+	if err != nil {
+		return xerrors.Errorf("failed to memory PublishStream: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.publish(streamKey, values)
+	return nil
+}
+
+func (c *memoryClient) PublishStreamIfLatest(_ context.Context, streamKey, latestID string, messages map[string]interface{}) error {
+	values, err := memdb.StringifyMessages(messages)
+	if err != nil {
+		return xerrors.Errorf("failed to memory PublishStreamIfLatest: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expireIfNeeded(streamKey)
+	var currentID string
+	if stream := c.streams[streamKey]; len(stream) > 0 {
+		currentID = stream[len(stream)-1].id.String()
+	}
+	if currentID != latestID {
+		return errs.NewConflictError(fmt.Sprintf("stream has been updated. streamKey: %s, latestID: %s", streamKey, latestID))
+	}
+
+	c.publish(streamKey, values)
+	return nil
+}
+
+func (c *memoryClient) publish(streamKey string, values map[string]string) {
+	c.sweep()
+	c.expireIfNeeded(streamKey)
+	now := c.now()
+	c.lastID = c.lastID.Next(now)
+	stream := append(c.streams[streamKey], streamMessage{id: c.lastID, values: values})
+	if maxLen := int(c.retention.MaxLen); maxLen > 0 && len(stream) > maxLen {
+		stream = stream[len(stream)-maxLen:]
+	}
+	if c.retention.MaxAge > 0 {
+		minID := memdb.MinStreamID(now.Add(-c.retention.MaxAge))
+		for len(stream) > 1 && stream[0].id.Less(minID) {
+			stream = stream[1:]
+		}
+	}
+	c.streams[streamKey] = stream
+
+	close(c.notify)
+	c.notify = make(chan struct{})
+}
+
+func (c *memoryClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error) {
+	prev, err := memdb.ParseStreamID(previousID)
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to memory ReadStream. err: %w, streamKey: %s, messageID: %s", err, streamKey, previousID)
+	}
+
+	timer := time.NewTimer(subscribeDuration)
+	defer timer.Stop()
+
+	for {
+		c.mu.Lock()
+		c.expireIfNeeded(streamKey)
+		msg, ok := lastMessageAfter(c.streams[streamKey], prev)
+		notify := c.notify
+		c.mu.Unlock()
+
+		if ok {
+			v, ok := msg.values[messageKey]
+			if !ok {
+				loggers.Logger(ctx).Warn("message key does not exist in stream message", zap.Reflect("message", msg.values))
+				return "", "", nil
+			}
+			return msg.id.String(), v, nil
+		}
+
+		select {
+		case <-notify:
+		case <-timer.C:
+			return "", "", errs.NewNotFoundError("response nil from stream")
+		case <-ctx.Done():
+			return "", "", xerrors.Errorf("failed to memory ReadStream. err: %w, streamKey: %s, messageID: %s", ctx.Err(), streamKey, previousID)
+		}
+	}
+}
+
+func (c *memoryClient) ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (id, message string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expireIfNeeded(streamKey)
+	stream := c.streams[streamKey]
+	if len(stream) == 0 {
+		return "", "", errs.NewNotFoundError(fmt.Sprintf("%s does not exist", streamKey))
+	}
+
+	msg := stream[len(stream)-1]
+	v, ok := msg.values[messageKey]
+	if !ok {
+		loggers.Logger(ctx).Warn("message key does not exist in stream message", zap.Reflect("message", msg.values))
+		return "", "", nil
+	}
+	return msg.id.String(), v, nil
+}
+
+func (c *memoryClient) ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]gateways.StreamMessage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expireIfNeeded(streamKey)
+	stream := c.streams[streamKey]
+	result := make([]gateways.StreamMessage, 0, len(stream))
+	for _, msg := range stream {
+		v, ok := msg.values[messageKey]
+		if !ok {
+			loggers.Logger(ctx).Warn("message key does not exist in stream message", zap.Reflect("message", msg.values))
+			continue
+		}
+		result = append(result, gateways.StreamMessage{
+			ID:          msg.id.String(),
+			Message:     v,
+			PublishedAt: msg.id.Time(),
+		})
+	}
+	return result, nil
+}
+
+func (c *memoryClient) Expire(_ context.Context, key string, duration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expireIfNeeded(key)
+	if c.exists(key) {
+		c.setExpire(key, duration)
+	}
+	return nil
+}
+
+func (c *memoryClient) exists(key string) bool {
+	if _, ok := c.values[key]; ok {
+		return true
+	}
+	if _, ok := c.sets[key]; ok {
+		return true
+	}
+	_, ok := c.streams[key]
+	return ok
+}
+
+func (c *memoryClient) delete(key string) {
+	delete(c.values, key)
+	delete(c.sets, key)
+	delete(c.streams, key)
+	delete(c.expires, key)
+}
+
+func (c *memoryClient) setExpire(key string, duration time.Duration) {
+	if duration <= 0 {
+		delete(c.expires, key)
+		return
+	}
+	c.expires[key] = c.now().Add(duration)
+}
+
+func (c *memoryClient) expireIfNeeded(key string) {
+	if at, ok := c.expires[key]; ok && !c.now().Before(at) {
+		c.delete(key)
+	}
+}
+
+// sweep drops expired keys that are never accessed again, such as abandoned rooms.
+func (c *memoryClient) sweep() {
+	now := c.now()
+	if now.Before(c.nextSweep) {
+		return
+	}
+	c.nextSweep = now.Add(sweepInterval)
+
+	for key := range c.expires {
+		c.expireIfNeeded(key)
+	}
+}
+
+func lastMessageAfter(stream []streamMessage, prev memdb.StreamID) (streamMessage, bool) {
+	if len(stream) == 0 {
+		return streamMessage{}, false
+	}
+	last := stream[len(stream)-1]
+	if !prev.Less(last.id) {
+		return streamMessage{}, false
+	}
+	return last, true
+}
@@ -0,0 +1,161 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+)
+
+const (
+	resultOK       = "ok"
+	resultNotFound = "not_found"
+	resultConflict = "conflict"
+	resultError    = "error"
+)
+
+type (
+	// memDBClient observes the latency of each method of the wrapped client.
+	memDBClient struct {
+		cli      gateways.MemDBClient
+		duration *prometheus.HistogramVec
+	}
+)
+
+// InstrumentMemDBClient wraps cli to record porker_memdb_request_duration_seconds by method and result.
+// ReadStream blocks until a message arrives, so its latency includes the waiting time.
+func InstrumentMemDBClient(cli gateways.MemDBClient, registerer prometheus.Registerer) gateways.MemDBClient {
+	return &memDBClient{
+		cli: cli,
+		duration: promauto.With(registerer).NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "memdb",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of mem db requests.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "result"}),
+	}
+}
+
+func (c *memDBClient) observe(method string, start time.Time, err error) {
+	c.duration.WithLabelValues(method, result(err)).Observe(time.Since(start).Seconds())
+}
+
+func result(err error) string {
+	switch {
+	case err == nil:
+		return resultOK
+	case errs.IsNotFoundError(err):
+		return resultNotFound
+	case errs.IsConflictError(err):
+		return resultConflict
+	default:
+		return resultError
+	}
+}
+
+func (c *memDBClient) Ping(ctx context.Context) error {
+	start := time.Now()
+	err := c.cli.Ping(ctx)
+	c.observe("Ping", start, err)
+	return err
+}
+
+// Close is not observed because it is called only once on shutdown.
+func (c *memDBClient) Close() error {
+	return c.cli.Close()
+}
+
+func (c *memDBClient) Set(ctx context.Context, key string, value interface{}, duration time.Duration) error {
+	start := time.Now()
+	err := c.cli.Set(ctx, key, value, duration)
+	c.observe("Set", start, err)
+	return err
+}
+
+func (c *memDBClient) SetNX(ctx context.Context, key string, value interface{}, duration time.Duration) error {
+	start := time.Now()
+	err := c.cli.SetNX(ctx, key, value, duration)
+	c.observe("SetNX", start, err)
+	return err
+}
+
+func (c *memDBClient) Get(ctx context.Context, key string) (string, error) {
+	start := time.Now()
+	v, err := c.cli.Get(ctx, key)
+	c.observe("Get", start, err)
+	return v, err
+}
+
+func (c *memDBClient) Del(ctx context.Context, keys ...string) error {
+	start := time.Now()
+	err := c.cli.Del(ctx, keys...)
+	c.observe("Del", start, err)
+	return err
+}
+
+func (c *memDBClient) SAdd(ctx context.Context, key string, values ...interface{}) error {
+	start := time.Now()
+	err := c.cli.SAdd(ctx, key, values...)
+	c.observe("SAdd", start, err)
+	return err
+}
+
+func (c *memDBClient) SRem(ctx context.Context, key string, members ...interface{}) error {
+	start := time.Now()
+	err := c.cli.SRem(ctx, key, members...)
+	c.observe("SRem", start, err)
+	return err
+}
+
+func (c *memDBClient) SMembers(ctx context.Context, key string) ([]string, error) {
+	start := time.Now()
+	members, err := c.cli.SMembers(ctx, key)
+	c.observe("SMembers", start, err)
+	return members, err
+}
+
+func (c *memDBClient) PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) error {
+	start := time.Now()
+	err := c.cli.PublishStream(ctx, streamKey, messages)
+	c.observe("PublishStream", start, err)
+	return err
+}
+
+func (c *memDBClient) PublishStreamIfLatest(ctx context.Context, streamKey, latestID string, messages map[string]interface{}) error {
+	start := time.Now()
+	err := c.cli.PublishStreamIfLatest(ctx, streamKey, latestID, messages)
+	c.observe("PublishStreamIfLatest", start, err)
+	return err
+}
+
+func (c *memDBClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (string, string, error) {
+	start := time.Now()
+	id, message, err := c.cli.ReadStream(ctx, streamKey, messageKey, previousID)
+	c.observe("ReadStream", start, err)
+	return id, message, err
+}
+
+func (c *memDBClient) ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (string, string, error) {
+	start := time.Now()
+	id, message, err := c.cli.ReadStreamLatest(ctx, streamKey, messageKey)
+	c.observe("ReadStreamLatest", start, err)
+	return id, message, err
+}
+
+func (c *memDBClient) ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]gateways.StreamMessage, error) {
+	start := time.Now()
+	messages, err := c.cli.ReadStreamRange(ctx, streamKey, messageKey)
+	c.observe("ReadStreamRange", start, err)
+	return messages, err
+}
+
+func (c *memDBClient) Expire(ctx context.Context, key string, duration time.Duration) error {
+	start := time.Now()
+	err := c.cli.Expire(ctx, key, duration)
+	c.observe("Expire", start, err)
+	return err
+}
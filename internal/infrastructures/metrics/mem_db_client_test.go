@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/memory"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+)
+
+func sampleCount(t *testing.T, h prometheus.Histogram) uint64 {
+	m := &dto.Metric{}
+	if err := h.Write(m); err != nil {
+		t.Fatalf("failed to Write: %v", err)
+	}
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestInstrumentMemDBClient(t *testing.T) {
+	ctx := context.Background()
+	registry := prometheus.NewRegistry()
+	cli := InstrumentMemDBClient(memory.NewMemoryClient(gateways.StreamRetention{MaxLen: 1}), registry)
+
+	if err := cli.Set(ctx, "key", "value", time.Minute); err != nil {
+		t.Fatalf("failed to Set: %v", err)
+	}
+	if v, err := cli.Get(ctx, "key"); err != nil || v != "value" {
+		t.Errorf("expected %s, actual %s, err: %v", "value", v, err)
+	}
+	if _, err := cli.Get(ctx, "missing"); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, actual %v", err)
+	}
+	if err := cli.PublishStreamIfLatest(ctx, "stream", "", map[string]interface{}{"m": "1"}); err != nil {
+		t.Fatalf("failed to PublishStreamIfLatest: %v", err)
+	}
+	if err := cli.PublishStreamIfLatest(ctx, "stream", "", map[string]interface{}{"m": "2"}); !errs.IsConflictError(err) {
+		t.Errorf("expected ConflictError, actual %v", err)
+	}
+
+	c := cli.(*memDBClient)
+	for _, tc := range []struct {
+		method, result string
+	}{
+		{"Set", resultOK},
+		{"Get", resultOK},
+		{"Get", resultNotFound},
+		{"PublishStreamIfLatest", resultOK},
+		{"PublishStreamIfLatest", resultConflict},
+	} {
+		h := c.duration.WithLabelValues(tc.method, tc.result).(prometheus.Histogram)
+		if n := sampleCount(t, h); n != 1 {
+			t.Errorf("%s %s: expected 1 observation, actual %d", tc.method, tc.result, n)
+		}
+	}
+
+	if n := testutil.CollectAndCount(c.duration); n != 5 {
+		t.Errorf("expected 5 series, actual %d", n)
+	}
+}
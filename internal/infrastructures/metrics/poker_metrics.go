@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
+)
+
+const (
+	namespace = "porker"
+)
+
+type (
+	pokerMetrics struct {
+		activeRooms     prometheus.Gauge
+		activeListeners prometheus.Gauge
+		activeWatchers  prometheus.Gauge
+		votes           prometheus.Counter
+		reveals         prometheus.Counter
+		resets          prometheus.Counter
+	}
+)
+
+func NewPokerMetrics(registerer prometheus.Registerer) ports.PokerMetrics {
+	factory := promauto.With(registerer)
+	return &pokerMetrics{
+		activeRooms: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "active_rooms",
+			Help:      "Number of rooms listened to by this process.",
+		}),
+		activeListeners: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "active_room_streams",
+			Help:      "Number of open EnterRoom streams.",
+		}),
+		activeWatchers: factory.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "active_room_watchers",
+			Help:      "Number of open room feeds watching rooms without membership.",
+		}),
+		votes: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "votes_total",
+			Help:      "Number of votes.",
+		}),
+		reveals: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reveals_total",
+			Help:      "Number of revealed rounds.",
+		}),
+		resets: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "resets_total",
+			Help:      "Number of room resets.",
+		}),
+	}
+}
+
+func (m *pokerMetrics) RoomActivated() {
+	m.activeRooms.Inc()
+}
+
+func (m *pokerMetrics) RoomDeactivated() {
+	m.activeRooms.Dec()
+}
+
+func (m *pokerMetrics) ListenerAdded() {
+	m.activeListeners.Inc()
+}
+
+func (m *pokerMetrics) ListenerRemoved() {
+	m.activeListeners.Dec()
+}
+
+func (m *pokerMetrics) WatcherAdded() {
+	m.activeWatchers.Inc()
+}
+
+func (m *pokerMetrics) WatcherRemoved() {
+	m.activeWatchers.Dec()
+}
+
+func (m *pokerMetrics) Voted() {
+	m.votes.Inc()
+}
+
+func (m *pokerMetrics) Revealed() {
+	m.reveals.Inc()
+}
+
+func (m *pokerMetrics) Reset() {
+	m.resets.Inc()
+}
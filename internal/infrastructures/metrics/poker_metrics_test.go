@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestPokerMetrics(t *testing.T) {
+	m := NewPokerMetrics(prometheus.NewRegistry())
+	pm := m.(*pokerMetrics)
+
+	m.RoomActivated()
+	m.RoomActivated()
+	m.RoomDeactivated()
+	m.ListenerAdded()
+	m.WatcherAdded()
+	m.WatcherAdded()
+	m.WatcherRemoved()
+	m.Voted()
+	m.Voted()
+	m.Revealed()
+	m.Reset()
+
+	for name, tc := range map[string]struct {
+		collector prometheus.Collector
+		expected  float64
+	}{
+		"active_rooms":         {pm.activeRooms, 1},
+		"active_room_streams":  {pm.activeListeners, 1},
+		"active_room_watchers": {pm.activeWatchers, 1},
+		"votes_total":          {pm.votes, 2},
+		"reveals_total":        {pm.reveals, 1},
+		"resets_total":         {pm.resets, 1},
+	} {
+		if v := testutil.ToFloat64(tc.collector); v != tc.expected {
+			t.Errorf("%s: expected %v, actual %v", name, tc.expected, v)
+		}
+	}
+}
+
+func TestNewPokerMetrics_Registered(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	NewPokerMetrics(registry)
+
+	if n, err := testutil.GatherAndCount(registry); err != nil || n != 6 {
+		t.Errorf("expected 6 metrics, actual %d, err: %v", n, err)
+	}
+}
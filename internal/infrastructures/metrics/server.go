@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// NewHTTPServer returns a server that exposes the metrics of gatherer at /metrics.
+func NewHTTPServer(port string, gatherer prometheus.Gatherer) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+	return &http.Server{
+		Addr:    net.JoinHostPort("", port),
+		Handler: mux,
+	}
+}
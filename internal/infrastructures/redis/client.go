@@ -3,6 +3,8 @@ package redis
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -17,21 +19,96 @@ const (
 	maxRetries = 5
 )
 
+// publishStreamIfLatestScript appends a message only if the latest message ID of the stream is ARGV[1].
+// ARGV[2] is the max length of the stream, ARGV[3] is the min ID kept in the stream (empty to keep all)
+// and the rest are field/value pairs of the message.
+var publishStreamIfLatestScript = redis.NewScript(`
+redis.replicate_commands()
+local latest = redis.call('XREVRANGE', KEYS[1], '+', '-', 'COUNT', 1)
+local latestID = ''
+if #latest > 0 then
+	latestID = latest[1][1]
+end
+if latestID ~= ARGV[1] then
+	return 0
+end
+redis.call('XADD', KEYS[1], 'MAXLEN', ARGV[2], '*', unpack(ARGV, 4))
+if ARGV[3] ~= '' then
+	redis.call('XTRIM', KEYS[1], 'MINID', ARGV[3])
+end
+return 1
+`)
+
 type (
 	redisClient struct {
-		cli redis.Cmdable
+		cli       redis.UniversalClient
+		retention gateways.StreamRetention
 	}
 )
 
-func NewRedisClient(config Config) gateways.MemDBClient {
+func NewRedisClient(config Config, retention gateways.StreamRetention) (gateways.MemDBClient, error) {
+	cli, err := newUniversalClient(config)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to newUniversalClient: %w", err)
+	}
+
 	return &redisClient{
-		cli: redis.NewClient(&redis.Options{
-			Addr:       config.HostPort,
-			Password:   config.Password, // no password set
-			DB:         config.DB,       // use default DB
-			MaxRetries: maxRetries,
-		}),
+		cli:       cli,
+		retention: retention,
+	}, nil
+}
+
+func newUniversalClient(config Config) (redis.UniversalClient, error) {
+	opts, err := universalOptions(config)
+	if err != nil {
+		return nil, err
+	}
+
+	switch config.Mode {
+	case ModeSentinel:
+		return redis.NewFailoverClient(opts.Failover()), nil
+	case ModeCluster:
+		return redis.NewClusterClient(opts.Cluster()), nil
+	default:
+		return redis.NewClient(opts.Simple()), nil
+	}
+}
+
+func universalOptions(config Config) (*redis.UniversalOptions, error) {
+	addrs := config.Addrs
+	if len(addrs) == 0 {
+		addrs = []string{config.HostPort}
+	}
+
+	switch config.Mode {
+	case ModeStandalone:
+	case ModeSentinel:
+		if config.MasterName == "" {
+			return nil, xerrors.New("master_name is required in sentinel mode")
+		}
+	case ModeCluster:
+		if config.DB != 0 {
+			return nil, xerrors.Errorf("db must be 0 in cluster mode: %d", config.DB)
+		}
+	default:
+		return nil, xerrors.Errorf("unknown redis mode: %s", config.Mode)
 	}
+
+	tlsConfig, err := newTLSConfig(config.TLS)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to newTLSConfig: %w", err)
+	}
+
+	return &redis.UniversalOptions{
+		Addrs:            addrs,
+		MasterName:       config.MasterName,
+		SentinelPassword: config.SentinelPassword,
+		Username:         config.Username,
+		Password:         config.Password,
+		DB:               config.DB,
+		MaxRetries:       maxRetries,
+		TLSConfig:        tlsConfig,
+	}, nil
 }
 
 func (c *redisClient) Ping(ctx context.Context) error {
@@ -41,6 +118,13 @@ func (c *redisClient) Ping(ctx context.Context) error {
 	return nil
 }
 
+func (c *redisClient) Close() error {
+	if err := c.cli.Close(); err != nil {
+		return xerrors.Errorf("failed to redis Close: %w", err)
+	}
+	return nil
+}
+
 func (c *redisClient) Set(ctx context.Context, key string, value interface{}, duration time.Duration) error {
 	if err := c.cli.Set(ctx, key, value, duration).Err(); err != nil {
 		return xerrors.Errorf("failed to redis Set: %w", err)
@@ -66,10 +150,10 @@ func (c *redisClient) Get(ctx context.Context, key string) (string, error) {
 	return val, nil
 }
 
-func (c *redisClient) Del(ctx context.Context, key string) error {
-	err := c.cli.Del(ctx, key).Err()
+func (c *redisClient) Del(ctx context.Context, keys ...string) error {
+	err := c.cli.Del(ctx, keys...).Err()
 	if err == redis.Nil {
-		return errs.NewNotFoundError(fmt.Sprintf("%s does not exist", key))
+		return errs.NewNotFoundError(fmt.Sprintf("%v does not exist", keys))
 	}
 	if err != nil {
 		return xerrors.Errorf("failed to redis Del: %w", err)
@@ -112,17 +196,49 @@ func (c *redisClient) PublishStream(ctx context.Context, streamKey string, messa
 		values = append(values, k, v)
 	}
 
-	if err := c.cli.XAdd(ctx, &redis.XAddArgs{
-		Stream: streamKey,
-		MaxLen: 1,
-		ID:     "*",
-		Values: values,
-	}).Err(); err != nil {
+	if _, err := c.cli.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: streamKey,
+			MaxLen: c.retention.MaxLen,
+			ID:     "*",
+			Values: values,
+		})
+		if minID := c.minID(); minID != "" {
+			pipe.Do(ctx, "XTRIM", streamKey, "MINID", minID)
+		}
+		return nil
+	}); err != nil {
 		return xerrors.Errorf("failed to redis XAdd: %w", err)
 	}
 	return nil
 }
 
+func (c *redisClient) PublishStreamIfLatest(ctx context.Context, streamKey, latestID string, messages map[string]interface{}) error {
+	args := make([]interface{}, 0, 3+len(messages)*2)
+	args = append(args, latestID, c.retention.MaxLen, c.minID())
+	for k, v := range messages {
+		args = append(args, k, v)
+	}
+
+	published, err := publishStreamIfLatestScript.Run(ctx, c.cli, []string{streamKey}, args...).Int()
+	if err != nil {
+		return xerrors.Errorf("failed to redis publishStreamIfLatestScript: %w", err)
+	}
+	if published == 0 {
+		return errs.NewConflictError(fmt.Sprintf("stream has been updated. streamKey: %s, latestID: %s", streamKey, latestID))
+	}
+	return nil
+}
+
+// minID returns the oldest stream ID to keep by MaxAge of the retention, or empty if there is no age limit.
+// Trimming by MINID needs Redis 6.2 or later.
+func (c *redisClient) minID() string {
+	if c.retention.MaxAge <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d-0", time.Now().Add(-c.retention.MaxAge).UnixNano()/int64(time.Millisecond))
+}
+
 func (c *redisClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error) {
 	const subscribeDuration = 3 * time.Second
 
@@ -150,7 +266,44 @@ func (c *redisClient) ReadStream(ctx context.Context, streamKey, messageKey, pre
 }
 
 func (c *redisClient) ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (id, message string, err error) {
-	return c.ReadStream(ctx, streamKey, messageKey, "0")
+	msgs, err := c.cli.XRevRangeN(ctx, streamKey, "+", "-", 1).Result()
+	if err != nil {
+		return "", "", xerrors.Errorf("failed to redis XRevRangeN. err: %w, streamKey: %s", err, streamKey)
+	}
+	if len(msgs) == 0 {
+		return "", "", errs.NewNotFoundError(fmt.Sprintf("%s does not exist", streamKey))
+	}
+
+	msg := msgs[0]
+	v, ok := msg.Values[messageKey].(string)
+	if !ok {
+		loggers.Logger(ctx).Warn("cast to string from stream message failed", zap.Reflect("message", msg))
+		return "", "", nil
+	}
+
+	return msg.ID, v, nil
+}
+
+func (c *redisClient) ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]gateways.StreamMessage, error) {
+	msgs, err := c.cli.XRange(ctx, streamKey, "-", "+").Result()
+	if err != nil {
+		return nil, xerrors.Errorf("failed to redis XRange. err: %w, streamKey: %s", err, streamKey)
+	}
+
+	result := make([]gateways.StreamMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		v, ok := msg.Values[messageKey].(string)
+		if !ok {
+			loggers.Logger(ctx).Warn("cast to string from stream message failed", zap.Reflect("message", msg))
+			continue
+		}
+		result = append(result, gateways.StreamMessage{
+			ID:          msg.ID,
+			Message:     v,
+			PublishedAt: streamIDTime(msg.ID),
+		})
+	}
+	return result, nil
 }
 
 func (c *redisClient) Expire(ctx context.Context, key string, duration time.Duration) error {
@@ -160,3 +313,15 @@ func (c *redisClient) Expire(ctx context.Context, key string, duration time.Dura
 
 	return nil
 }
+
+// streamIDTime returns the time part of a stream ID in the form of "<milliseconds>-<sequence>".
+func streamIDTime(id string) time.Time {
+	if i := strings.IndexByte(id, '-'); i >= 0 {
+		id = id[:i]
+	}
+	ms, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
@@ -0,0 +1,108 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+)
+
+func TestNewUniversalClient(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		assert func(cli redis.UniversalClient) bool
+	}{
+		{
+			name:   "standalone",
+			config: Config{Mode: ModeStandalone, HostPort: "localhost:6379"},
+			assert: func(cli redis.UniversalClient) bool {
+				c, ok := cli.(*redis.Client)
+				return ok && c.Options().Addr == "localhost:6379"
+			},
+		},
+		{
+			name:   "sentinel",
+			config: Config{Mode: ModeSentinel, Addrs: []string{"s1:26379", "s2:26379"}, MasterName: "mymaster"},
+			assert: func(cli redis.UniversalClient) bool {
+				_, ok := cli.(*redis.Client)
+				return ok
+			},
+		},
+		{
+			name:   "cluster with a single seed",
+			config: Config{Mode: ModeCluster, HostPort: "node1:6379"},
+			assert: func(cli redis.UniversalClient) bool {
+				c, ok := cli.(*redis.ClusterClient)
+				return ok && len(c.Options().Addrs) == 1
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli, err := newUniversalClient(tt.config)
+			if err != nil {
+				t.Fatalf("failed to newUniversalClient: %v", err)
+			}
+			defer cli.Close()
+
+			if !tt.assert(cli) {
+				t.Errorf("unexpected client: %T", cli)
+			}
+		})
+	}
+}
+
+func TestNewUniversalClient_InvalidConfig(t *testing.T) {
+	for name, config := range map[string]Config{
+		"unknown mode":           {Mode: "unknown"},
+		"sentinel without name":  {Mode: ModeSentinel},
+		"cluster with db number": {Mode: ModeCluster, DB: 1},
+	} {
+		if _, err := newUniversalClient(config); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestRedisClient_PublishStreamIfLatest(t *testing.T) {
+	ctx := context.Background()
+	s, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to run redis: %v", err)
+	}
+	defer s.Close()
+
+	cli, err := NewRedisClient(Config{Mode: ModeStandalone, HostPort: s.Addr()}, gateways.StreamRetention{MaxLen: 3})
+	if err != nil {
+		t.Fatalf("failed to NewRedisClient: %v", err)
+	}
+
+	if err := cli.PublishStreamIfLatest(ctx, "stream", "", map[string]interface{}{"msg": "first"}); err != nil {
+		t.Fatalf("failed to PublishStreamIfLatest to empty stream: %v", err)
+	}
+	id, _, err := cli.ReadStreamLatest(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+
+	if err := cli.PublishStreamIfLatest(ctx, "stream", id, map[string]interface{}{"msg": "second"}); err != nil {
+		t.Fatalf("failed to PublishStreamIfLatest: %v", err)
+	}
+	err = cli.PublishStreamIfLatest(ctx, "stream", id, map[string]interface{}{"msg": "third"})
+	if !errs.IsConflictError(err) {
+		t.Errorf("expected ConflictError, actual %v", err)
+	}
+
+	msgs, err := cli.ReadStreamRange(ctx, "stream", "msg")
+	if err != nil {
+		t.Fatalf("failed to ReadStreamRange: %v", err)
+	}
+	if len(msgs) != 2 || msgs[0].Message != "first" || msgs[1].Message != "second" {
+		t.Errorf("unexpected messages: %v", msgs)
+	}
+}
@@ -1,9 +1,40 @@
 package redis
 
+const (
+	ModeStandalone = "standalone"
+	ModeSentinel   = "sentinel"
+	ModeCluster    = "cluster"
+)
+
 // Config is
 // Redis settings.
 type Config struct {
+	// Mode is the topology of Redis. standalone, sentinel or cluster.
+	Mode     string `envconfig:"mode" default:"standalone"`
 	HostPort string `envconfig:"host_port" default:"localhost:6379"`
-	Password string `envconfig:"password" default:"password"`
-	DB       int    `envconfig:"db" default:"0"`
+	// Addrs is a comma separated list of sentinel addresses in sentinel mode, or seed node addresses in cluster mode.
+	// HostPort is used when it is empty.
+	Addrs []string `envconfig:"addrs"`
+	// MasterName is the name of the master monitored by sentinels. It is required in sentinel mode.
+	MasterName       string `envconfig:"master_name"`
+	SentinelPassword string `envconfig:"sentinel_password"`
+	// Username is the ACL user. The default user is used when it is empty.
+	Username string    `envconfig:"username"`
+	Password string    `envconfig:"password"`
+	DB       int       `envconfig:"db" default:"0"`
+	TLS      TLSConfig `envconfig:"tls"`
+}
+
+// TLSConfig is
+// TLS settings of the connection to Redis.
+type TLSConfig struct {
+	Enabled bool `envconfig:"enabled" default:"false"`
+	// CAFile is a PEM bundle to verify the server certificate. The system roots are used when it is empty.
+	CAFile string `envconfig:"ca_file"`
+	// CertFile and KeyFile are the client certificate for servers requiring mutual TLS.
+	CertFile string `envconfig:"cert_file"`
+	KeyFile  string `envconfig:"key_file"`
+	// ServerName overrides the host name used to verify the server certificate.
+	ServerName         string `envconfig:"server_name"`
+	InsecureSkipVerify bool   `envconfig:"insecure_skip_verify" default:"false"`
 }
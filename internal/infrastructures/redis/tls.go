@@ -0,0 +1,47 @@
+package redis
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"golang.org/x/xerrors"
+)
+
+// newTLSConfig builds the client TLS config, or returns nil when TLS is disabled.
+func newTLSConfig(config TLSConfig) (*tls.Config, error) {
+	if !config.Enabled {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         config.ServerName,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CAFile != "" {
+		pem, err := ioutil.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to read ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, xerrors.Errorf("no certificate found in ca file: %s", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	switch {
+	case config.CertFile != "" && config.KeyFile != "":
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case config.CertFile != "" || config.KeyFile != "":
+		return nil, xerrors.New("both cert_file and key_file are required for a client certificate")
+	}
+
+	return tlsConfig, nil
+}
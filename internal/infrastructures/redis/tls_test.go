@@ -0,0 +1,113 @@
+package redis
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"github.com/swallowarc/porker-rpc/internal/tests/testcerts"
+)
+
+// runTLSRedis starts a TLS Redis stand-in that requires a client certificate and an ACL user.
+func runTLSRedis(t *testing.T, ca *testcerts.CA) *miniredis.Miniredis {
+	certPEM, keyPEM := ca.Issue(t, 2, "localhost", x509.ExtKeyUsageServerAuth)
+	serverCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		t.Fatalf("failed to load server certificate: %v", err)
+	}
+	clientCAs := ca.Pool()
+
+	s, err := miniredis.RunTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+	if err != nil {
+		t.Fatalf("failed to run tls redis: %v", err)
+	}
+	s.RequireUserAuth("porker", "secret")
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestNewRedisClient_TLS(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	ca := testcerts.NewCA(t)
+	s := runTLSRedis(t, ca)
+	clientCert, clientKey := ca.Issue(t, 3, "localhost", x509.ExtKeyUsageClientAuth)
+
+	cli, err := NewRedisClient(Config{
+		Mode:     ModeStandalone,
+		HostPort: s.Addr(),
+		Username: "porker",
+		Password: "secret",
+		TLS: TLSConfig{
+			Enabled:    true,
+			CAFile:     testcerts.WriteFile(t, dir, "ca.pem", ca.PEM, time.Now()),
+			CertFile:   testcerts.WriteFile(t, dir, "client.pem", clientCert, time.Now()),
+			KeyFile:    testcerts.WriteFile(t, dir, "client-key.pem", clientKey, time.Now()),
+			ServerName: "localhost",
+		},
+	}, gateways.StreamRetention{MaxLen: 1})
+	if err != nil {
+		t.Fatalf("failed to NewRedisClient: %v", err)
+	}
+
+	if err := cli.Ping(ctx); err != nil {
+		t.Fatalf("failed to Ping: %v", err)
+	}
+	if err := cli.Set(ctx, "key", "value", time.Minute); err != nil {
+		t.Fatalf("failed to Set: %v", err)
+	}
+	if v, err := cli.Get(ctx, "key"); err != nil || v != "value" {
+		t.Errorf("expected %s, actual %s, err: %v", "value", v, err)
+	}
+}
+
+func TestNewRedisClient_TLSUntrustedServer(t *testing.T) {
+	dir := t.TempDir()
+	s := runTLSRedis(t, testcerts.NewCA(t))
+	otherCA := testcerts.NewCA(t)
+	clientCert, clientKey := otherCA.Issue(t, 3, "localhost", x509.ExtKeyUsageClientAuth)
+
+	cli, err := NewRedisClient(Config{
+		Mode:     ModeStandalone,
+		HostPort: s.Addr(),
+		Username: "porker",
+		Password: "secret",
+		TLS: TLSConfig{
+			Enabled:    true,
+			CAFile:     testcerts.WriteFile(t, dir, "ca.pem", otherCA.PEM, time.Now()),
+			CertFile:   testcerts.WriteFile(t, dir, "client.pem", clientCert, time.Now()),
+			KeyFile:    testcerts.WriteFile(t, dir, "client-key.pem", clientKey, time.Now()),
+			ServerName: "localhost",
+		},
+	}, gateways.StreamRetention{MaxLen: 1})
+	if err != nil {
+		t.Fatalf("failed to NewRedisClient: %v", err)
+	}
+
+	if err := cli.Ping(context.Background()); err == nil {
+		t.Error("expected error by untrusted server certificate")
+	}
+}
+
+func TestNewTLSConfig_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, config := range map[string]TLSConfig{
+		"missing ca file":  {Enabled: true, CAFile: filepath.Join(dir, "missing.pem")},
+		"ca without certs": {Enabled: true, CAFile: testcerts.WriteFile(t, dir, "empty.pem", []byte("empty"), time.Now())},
+		"cert without key": {Enabled: true, CertFile: testcerts.WriteFile(t, dir, "cert.pem", []byte("cert"), time.Now())},
+	} {
+		if _, err := newTLSConfig(config); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package rest_gateway
+
+// Config is
+// REST/JSON gateway settings.
+type Config struct {
+	// Enabled starts the HTTP listener translating JSON requests to PorkerService.
+	Enabled bool   `envconfig:"enabled" default:"false"`
+	Port    string `envconfig:"port" default:"8081"`
+}
@@ -0,0 +1,162 @@
+package rest_gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/auth"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/grpc_server"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// OpenAPIPath serves the OpenAPI document of the gateway.
+	OpenAPIPath = "/openapi.json"
+
+	bufSize = 1024 * 1024
+)
+
+var (
+	// forwardedHeaders are passed to the gRPC server as metadata without the Grpc-Metadata- prefix.
+	forwardedHeaders = map[string]bool{
+		"porker-login-id":   true,
+		"porker-session-id": true,
+		"traceparent":       true,
+		"tracestate":        true,
+	}
+)
+
+type (
+	gateway struct {
+		logger *zap.Logger
+		config Config
+		lis    net.Listener
+		server *http.Server
+		conn   *grpc.ClientConn
+		ready  chan struct{}
+	}
+)
+
+func NewRESTGateway(logger *zap.Logger, config Config) grpc_server.Gateway {
+	return &gateway{
+		logger: logger,
+		config: config,
+		ready:  make(chan struct{}),
+	}
+}
+
+// Serve translates HTTP requests into calls to server through an in-process connection,
+// so they go through the same interceptors as gRPC clients.
+func (g *gateway) Serve(server *grpc.Server, tlsConfig *tls.Config) error {
+	handler, err := g.handler(server)
+	if err != nil {
+		close(g.ready)
+		return xerrors.Errorf("failed to create handler: %w", err)
+	}
+
+	lis, err := net.Listen("tcp", net.JoinHostPort("", g.config.Port))
+	if err != nil {
+		close(g.ready)
+		return xerrors.Errorf("failed to listen: %w", err)
+	}
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+	g.lis = lis
+	g.server = &http.Server{
+		Handler: handler,
+	}
+	close(g.ready)
+
+	g.logger.Info("REST gateway start", zap.String("port", g.config.Port), zap.Bool("tls", tlsConfig != nil))
+	if err := g.server.Serve(lis); err != nil && err != http.ErrServerClosed {
+		return xerrors.Errorf("failed to Serve: %w", err)
+	}
+	return nil
+}
+
+func (g *gateway) handler(server *grpc.Server) (http.Handler, error) {
+	bufLis := bufconn.Listen(bufSize)
+	go func() {
+		// it stops with the gRPC server
+		if err := server.Serve(bufLis); err != nil {
+			g.logger.Debug("in-process listener stopped", zap.Error(err))
+		}
+	}()
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return bufLis.DialContext(ctx)
+		}),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Dial: %w", err)
+	}
+	g.conn = conn
+
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(matchHeader),
+		runtime.WithMetadata(func(_ context.Context, r *http.Request) metadata.MD {
+			// the identity of mTLS is of this connection, not of the in-process one
+			return auth.ForwardedClientCert(r.TLS)
+		}),
+	)
+	if err := porker.RegisterPorkerServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return nil, xerrors.Errorf("failed to RegisterPorkerServiceHandler: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(OpenAPIPath, serveOpenAPI)
+	mux.Handle("/", gwMux)
+	return mux, nil
+}
+
+func matchHeader(key string) (string, bool) {
+	k := strings.ToLower(key)
+	if forwardedHeaders[k] {
+		return k, true
+	}
+	if k == strings.ToLower(runtime.MetadataHeaderPrefix+auth.ForwardedClientCertKey) {
+		// only the gateway forwards the client certificate
+		return "", false
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(porker.OpenAPI)
+}
+
+// Shutdown waits for the running requests until ctx is done and then closes the remaining streams.
+func (g *gateway) Shutdown(ctx context.Context) error {
+	<-g.ready
+	if g.server == nil {
+		return nil
+	}
+
+	if err := g.server.Shutdown(ctx); err != nil {
+		// EnterRoom streams do not end by themselves
+		if err := g.server.Close(); err != nil {
+			return xerrors.Errorf("failed to Close: %w", err)
+		}
+	}
+	if err := g.conn.Close(); err != nil {
+		return xerrors.Errorf("failed to close connection: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+package rest_gateway
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/auth"
+	"github.com/swallowarc/porker-rpc/internal/tests/testcerts"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type (
+	fakePorkerServer struct {
+		porker.UnimplementedPorkerServiceServer
+	}
+)
+
+func loginID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("porker-login-id"); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (fakePorkerServer) CreateRoom(ctx context.Context, _ *porker.CreateRoomRequest) (*porker.CreateRoomResponse, error) {
+	return &porker.CreateRoomResponse{RoomId: "12345"}, nil
+}
+
+func (fakePorkerServer) Voting(_ context.Context, req *porker.VotingRequest) (*porker.NoBody, error) {
+	if req.RoomId != "12345" || req.Ballot.GetPoint() != porker.Point_POINT_3 {
+		return nil, status.Errorf(codes.NotFound, "unexpected vote: %v", req)
+	}
+	return &porker.NoBody{}, nil
+}
+
+func (fakePorkerServer) EnterRoom(req *porker.EnterRoomRequest, stream porker.PorkerService_EnterRoomServer) error {
+	for _, state := range []porker.RoomState{porker.RoomState_ROOM_STATE_TURN_DOWN, porker.RoomState_ROOM_STATE_OPEN} {
+		if err := stream.Send(&porker.PokerSituation{RoomId: req.RoomId, State: state}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// requireLogin stands for the auth interceptor to check that the headers reach the interceptors.
+func requireLogin(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if loginID(ctx) != "alice" {
+		return nil, status.Error(codes.Unauthenticated, "login required")
+	}
+	return handler(ctx, req)
+}
+
+func runGateway(t *testing.T) string {
+	return "http://" + serveGateway(t, grpc.NewServer(grpc.UnaryInterceptor(requireLogin)), nil)
+}
+
+// serveGateway serves the gateway of server and returns the address.
+func serveGateway(t *testing.T, server *grpc.Server, tlsConfig *tls.Config) string {
+	porker.RegisterPorkerServiceServer(server, fakePorkerServer{})
+	t.Cleanup(server.Stop)
+
+	g := NewRESTGateway(zap.NewNop(), Config{Enabled: true, Port: "0"}).(*gateway)
+	go func() {
+		if err := g.Serve(server, tlsConfig); err != nil {
+			t.Errorf("failed to Serve: %v", err)
+		}
+	}()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := g.Shutdown(ctx); err != nil {
+			t.Errorf("failed to Shutdown: %v", err)
+		}
+	})
+
+	<-g.ready
+	if g.lis == nil {
+		t.Fatal("gateway did not listen")
+	}
+	return g.lis.Addr().String()
+}
+
+func do(t *testing.T, method, url, body string, login bool) (int, string) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("failed to NewRequest: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if login {
+		req.Header.Set("Porker-Login-Id", "alice")
+		req.Header.Set("Porker-Session-Id", "session")
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to Do: %v", err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("failed to read body: %v", err)
+	}
+	return resp.StatusCode, string(b)
+}
+
+func TestGateway_Unary(t *testing.T) {
+	url := runGateway(t)
+
+	code, body := do(t, http.MethodPost, url+"/v1/rooms", "{}", true)
+	if code != http.StatusOK || !strings.Contains(body, `"roomId":"12345"`) {
+		t.Errorf("expected room id, actual %d %s", code, body)
+	}
+
+	code, body = do(t, http.MethodPost, url+"/v1/rooms/12345/votes", `{"point":"POINT_3"}`, true)
+	if code != http.StatusOK {
+		t.Errorf("expected %d, actual %d %s", http.StatusOK, code, body)
+	}
+}
+
+func TestGateway_Errors(t *testing.T) {
+	url := runGateway(t)
+
+	for name, tc := range map[string]struct {
+		method, path, body string
+		login              bool
+		expected           int
+	}{
+		"unauthenticated": {http.MethodPost, "/v1/rooms", "{}", false, http.StatusUnauthorized},
+		"not found":       {http.MethodPost, "/v1/rooms/99999/votes", `{"point":"POINT_3"}`, true, http.StatusNotFound},
+		"unknown path":    {http.MethodGet, "/v1/unknown", "", true, http.StatusNotFound},
+	} {
+		if code, body := do(t, tc.method, url+tc.path, tc.body, tc.login); code != tc.expected {
+			t.Errorf("%s: expected %d, actual %d %s", name, tc.expected, code, body)
+		}
+	}
+}
+
+func TestGateway_ServerStreaming(t *testing.T) {
+	url := runGateway(t)
+
+	code, body := do(t, http.MethodPost, url+"/v1/rooms/12345:enter", "{}", true)
+	if code != http.StatusOK {
+		t.Fatalf("expected %d, actual %d %s", http.StatusOK, code, body)
+	}
+
+	var states []string
+	s := bufio.NewScanner(strings.NewReader(body))
+	for s.Scan() {
+		var line struct {
+			Result struct {
+				RoomID string `json:"roomId"`
+				State  string `json:"state"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(s.Bytes(), &line); err != nil {
+			t.Fatalf("failed to Unmarshal %s: %v", s.Text(), err)
+		}
+		states = append(states, line.Result.State)
+	}
+	if strings.Join(states, ",") != "ROOM_STATE_TURN_DOWN,ROOM_STATE_OPEN" {
+		t.Errorf("unexpected situations: %v", states)
+	}
+}
+
+func TestGateway_OpenAPI(t *testing.T) {
+	url := runGateway(t)
+
+	code, body := do(t, http.MethodGet, url+OpenAPIPath, "", false)
+	if code != http.StatusOK {
+		t.Fatalf("expected %d, actual %d", http.StatusOK, code)
+	}
+	var doc struct {
+		Swagger string                     `json:"swagger"`
+		Paths   map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		t.Fatalf("failed to Unmarshal: %v", err)
+	}
+	if doc.Swagger != "2.0" || doc.Paths["/v1/rooms"] == nil {
+		t.Errorf("unexpected document: %s", body)
+	}
+}
+
+func TestGateway_ForwardedClientCert(t *testing.T) {
+	ca := testcerts.NewCA(t)
+	keyPair := func(serial int64, cn string, usage x509.ExtKeyUsage) tls.Certificate {
+		certPEM, keyPEM := ca.Issue(t, serial, cn, usage)
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			t.Fatalf("failed to X509KeyPair: %v", err)
+		}
+		return cert
+	}
+
+	forwarded := make(chan string, 1)
+	server := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var cn string
+		md, _ := metadata.FromIncomingContext(ctx)
+		if v := md.Get(auth.ForwardedClientCertKey); len(v) > 0 {
+			cert, err := x509.ParseCertificate([]byte(v[0]))
+			if err != nil {
+				t.Errorf("failed to ParseCertificate: %v", err)
+			} else {
+				cn = cert.Subject.CommonName
+			}
+		}
+		forwarded <- cn
+		return requireLogin(ctx, req, info, handler)
+	}))
+	addr := serveGateway(t, server, &tls.Config{
+		Certificates: []tls.Certificate{keyPair(2, "localhost", x509.ExtKeyUsageServerAuth)},
+		ClientCAs:    ca.Pool(),
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+	})
+
+	for name, tc := range map[string]struct {
+		certs    []tls.Certificate
+		expected string
+	}{
+		"client certificate":    {certs: []tls.Certificate{keyPair(3, "porker-client", x509.ExtKeyUsageClientAuth)}, expected: "porker-client"},
+		"no client certificate": {},
+	} {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: ca.Pool(), ServerName: "localhost", Certificates: tc.certs}}}
+		req, err := http.NewRequest(http.MethodPost, "https://"+addr+"/v1/rooms", strings.NewReader("{}"))
+		if err != nil {
+			t.Fatalf("failed to NewRequest: %v", err)
+		}
+		req.Header.Set("Porker-Login-Id", "alice")
+		// clients must not be able to forward a certificate by themselves
+		req.Header.Set(runtime.MetadataHeaderPrefix+auth.ForwardedClientCertKey, "forged")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s: failed to Do: %v", name, err)
+		}
+		_ = resp.Body.Close()
+		client.CloseIdleConnections()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("%s: expected %d, actual %d", name, http.StatusOK, resp.StatusCode)
+		}
+		if cn := <-forwarded; cn != tc.expected {
+			t.Errorf("%s: expected forwarded %q, actual %q", name, tc.expected, cn)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package room_feed
+
+// Config is
+// room feed listener settings.
+type Config struct {
+	// Enabled starts the HTTP listener streaming rooms as Server-Sent Events and WebSocket.
+	Enabled bool   `envconfig:"enabled" default:"false"`
+	Port    string `envconfig:"port" default:"8082"`
+	// AllowedOrigins are the origins of browsers allowed to follow rooms. "*" allows any origin.
+	// Browsers are not allowed unless their origins are listed.
+	AllowedOrigins []string `envconfig:"allowed_origins"`
+}
@@ -0,0 +1,72 @@
+package room_feed
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/grpc_server"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+)
+
+type (
+	gateway struct {
+		logger  *zap.Logger
+		config  Config
+		handler http.Handler
+		lis     net.Listener
+		server  *http.Server
+		ready   chan struct{}
+	}
+)
+
+// NewRoomFeedGateway serves handler along with the gRPC server, which is not used by the feed.
+func NewRoomFeedGateway(logger *zap.Logger, config Config, handler http.Handler) grpc_server.Gateway {
+	return &gateway{
+		logger:  logger,
+		config:  config,
+		handler: handler,
+		ready:   make(chan struct{}),
+	}
+}
+
+func (g *gateway) Serve(_ *grpc.Server, tlsConfig *tls.Config) error {
+	lis, err := net.Listen("tcp", net.JoinHostPort("", g.config.Port))
+	if err != nil {
+		close(g.ready)
+		return xerrors.Errorf("failed to listen: %w", err)
+	}
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+	g.lis = lis
+	g.server = &http.Server{
+		Handler: g.handler,
+	}
+	close(g.ready)
+
+	g.logger.Info("room feed start", zap.String("port", g.config.Port), zap.Bool("tls", tlsConfig != nil))
+	if err := g.server.Serve(lis); err != nil && err != http.ErrServerClosed {
+		return xerrors.Errorf("failed to Serve: %w", err)
+	}
+	return nil
+}
+
+// Shutdown waits for the running requests until ctx is done and then closes the remaining feeds.
+func (g *gateway) Shutdown(ctx context.Context) error {
+	<-g.ready
+	if g.server == nil {
+		return nil
+	}
+
+	if err := g.server.Shutdown(ctx); err != nil {
+		// feeds do not end by themselves
+		if err := g.server.Close(); err != nil {
+			return xerrors.Errorf("failed to Close: %w", err)
+		}
+	}
+	return nil
+}
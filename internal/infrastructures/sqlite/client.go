@@ -0,0 +1,58 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"golang.org/x/xerrors"
+	_ "modernc.org/sqlite" // pure-Go SQLite driver
+)
+
+const (
+	driverName = "sqlite"
+)
+
+// NewSQLiteClient opens the embedded SQLite database and creates tables if they do not exist.
+func NewSQLiteClient(ctx context.Context, config Config) (gateways.RDBClient, error) {
+	db, err := sql.Open(driverName, config.Path)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to open sqlite: %w", err)
+	}
+	// SQLite allows only one writer at a time, and each connection of ":memory:" is a different database.
+	// Keeping the only connection open also keeps the pragmas set by migrate.
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+
+	if err := migrate(ctx, db); err != nil {
+		_ = db.Close()
+		return nil, xerrors.Errorf("failed to migrate: %w", err)
+	}
+
+	return db, nil
+}
+
+func migrate(ctx context.Context, db *sql.DB) error {
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return xerrors.Errorf("failed to exec %q: %w", stmt, err)
+		}
+	}
+
+	for _, c := range columns {
+		var count int
+		if err := db.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, c.table, c.name).Scan(&count); err != nil {
+			return xerrors.Errorf("failed to check column %s.%s: %w", c.table, c.name, err)
+		}
+		if count > 0 {
+			continue
+		}
+		if _, err := db.ExecContext(ctx,
+			fmt.Sprintf(`ALTER TABLE %s ADD COLUMN %s %s`, c.table, c.name, c.definition)); err != nil {
+			return xerrors.Errorf("failed to add column %s.%s: %w", c.table, c.name, err)
+		}
+	}
+	return nil
+}
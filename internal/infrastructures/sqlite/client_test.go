@@ -0,0 +1,54 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewSQLiteClient_Migrate(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "porker.db")
+
+	// tables created by older versions do not have the card and started_at columns
+	db, err := sql.Open(driverName, path)
+	if err != nil {
+		t.Fatalf("failed to open: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `CREATE TABLE rounds (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		room_id     TEXT    NOT NULL,
+		story       TEXT    NOT NULL DEFAULT '',
+		revealed_at INTEGER NOT NULL
+	)`); err != nil {
+		t.Fatalf("failed to create old rounds: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `CREATE TABLE ballots (
+		round_id INTEGER NOT NULL,
+		seq      INTEGER NOT NULL,
+		login_id TEXT    NOT NULL,
+		point    TEXT    NOT NULL,
+		PRIMARY KEY (round_id, seq)
+	)`); err != nil {
+		t.Fatalf("failed to create old ballots: %v", err)
+	}
+	_ = db.Close()
+
+	// migrations can be applied any number of times
+	for i := 0; i < 2; i++ {
+		cli, err := NewSQLiteClient(ctx, Config{Path: path})
+		if err != nil {
+			t.Fatalf("failed to NewSQLiteClient: %v", err)
+		}
+		if _, err := cli.ExecContext(ctx,
+			`INSERT INTO rounds (id, room_id, started_at, revealed_at) VALUES (?, '11111', 1, 2)`, i); err != nil {
+			t.Errorf("failed to insert round with started_at: %v", err)
+		}
+		if _, err := cli.ExecContext(ctx,
+			`INSERT INTO ballots (round_id, seq, login_id, point, card) VALUES (?, ?, 'a', 'POINT_CARD', 'XL')`, i, i); err != nil {
+			t.Errorf("failed to insert ballot with card: %v", err)
+		}
+		_ = cli.Close()
+	}
+}
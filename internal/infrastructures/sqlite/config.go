@@ -0,0 +1,10 @@
+package sqlite
+
+// Config is
+// SQLite settings.
+type Config struct {
+	// Enabled archives the revealed rounds. Without it the rounds are not recorded and can not be listed.
+	Enabled bool `envconfig:"enabled" default:"true"`
+	// Path is the database file. ":memory:" keeps the database only in the process.
+	Path string `envconfig:"path" default:"porker.db"`
+}
@@ -0,0 +1,39 @@
+package sqlite
+
+// schema is applied in order on every start, so each statement must be idempotent.
+var schema = []string{
+	`PRAGMA foreign_keys = ON`,
+	`PRAGMA busy_timeout = 5000`,
+	`CREATE TABLE IF NOT EXISTS rounds (
+		id          INTEGER PRIMARY KEY AUTOINCREMENT,
+		room_id     TEXT    NOT NULL,
+		story       TEXT    NOT NULL DEFAULT '',
+		started_at  INTEGER NOT NULL DEFAULT 0,
+		revealed_at INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS rounds_room_id ON rounds (room_id, revealed_at)`,
+	`CREATE INDEX IF NOT EXISTS rounds_revealed_at ON rounds (revealed_at)`,
+	`CREATE TABLE IF NOT EXISTS ballots (
+		round_id INTEGER NOT NULL REFERENCES rounds (id),
+		seq      INTEGER NOT NULL,
+		login_id TEXT    NOT NULL,
+		point    TEXT    NOT NULL,
+		card     TEXT    NOT NULL DEFAULT '',
+		PRIMARY KEY (round_id, seq)
+	)`,
+}
+
+type (
+	column struct {
+		table      string
+		name       string
+		definition string
+	}
+)
+
+// columns are added to the tables created by older versions, because ALTER TABLE ADD COLUMN is not idempotent.
+var columns = []column{
+	{table: "ballots", name: "card", definition: "TEXT NOT NULL DEFAULT ''"},
+	// started_at is 0 for the rounds archived before it was recorded
+	{table: "rounds", name: "started_at", definition: "INTEGER NOT NULL DEFAULT 0"},
+}
@@ -0,0 +1,22 @@
+package tracing
+
+const (
+	ExporterNone = "none"
+	ExporterOTLP = "otlp"
+	ExporterFile = "file"
+)
+
+// Config is
+// OpenTelemetry tracing settings.
+type Config struct {
+	// Exporter is where spans are sent. Tracing is disabled with "none".
+	Exporter    string `envconfig:"exporter" default:"none"`
+	ServiceName string `envconfig:"service_name" default:"porker-rpc"`
+	// Endpoint is the host:port of the OTLP gRPC receiver.
+	Endpoint string `envconfig:"endpoint" default:"localhost:4317"`
+	Insecure bool   `envconfig:"insecure" default:"true"`
+	// FilePath is the file the "file" exporter appends spans to as JSON.
+	FilePath string `envconfig:"file_path" default:"porker-traces.json"`
+	// SampleRatio is the ratio of traces sampled when the caller has not decided it.
+	SampleRatio float64 `envconfig:"sample_ratio" default:"1"`
+}
@@ -0,0 +1,146 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/tracers"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	keyAttributeKey      = attribute.Key("memdb.key")
+	notFoundAttributeKey = attribute.Key("memdb.not_found")
+)
+
+type (
+	// memDBClient starts a span for each method of the wrapped client.
+	memDBClient struct {
+		cli gateways.MemDBClient
+	}
+)
+
+func TraceMemDBClient(cli gateways.MemDBClient) gateways.MemDBClient {
+	return &memDBClient{cli: cli}
+}
+
+func start(ctx context.Context, method string, keys ...string) (context.Context, trace.Span) {
+	if len(keys) == 0 {
+		return tracers.Start(ctx, "MemDBClient."+method)
+	}
+	return tracers.Start(ctx, "MemDBClient."+method, keyAttributeKey.StringSlice(keys))
+}
+
+// end ends the span. NotFoundError is an expected result of lookups, so it is not recorded as an error.
+func end(span trace.Span, err error) {
+	if errs.IsNotFoundError(err) {
+		span.SetAttributes(notFoundAttributeKey.Bool(true))
+		err = nil
+	}
+	tracers.End(span, err)
+}
+
+func (c *memDBClient) Ping(ctx context.Context) (err error) {
+	ctx, span := start(ctx, "Ping")
+	defer func() { end(span, err) }()
+
+	return c.cli.Ping(ctx)
+}
+
+func (c *memDBClient) Close() error {
+	return c.cli.Close()
+}
+
+func (c *memDBClient) Set(ctx context.Context, key string, value interface{}, duration time.Duration) (err error) {
+	ctx, span := start(ctx, "Set", key)
+	defer func() { end(span, err) }()
+
+	return c.cli.Set(ctx, key, value, duration)
+}
+
+func (c *memDBClient) SetNX(ctx context.Context, key string, value interface{}, duration time.Duration) (err error) {
+	ctx, span := start(ctx, "SetNX", key)
+	defer func() { end(span, err) }()
+
+	return c.cli.SetNX(ctx, key, value, duration)
+}
+
+func (c *memDBClient) Get(ctx context.Context, key string) (_ string, err error) {
+	ctx, span := start(ctx, "Get", key)
+	defer func() { end(span, err) }()
+
+	return c.cli.Get(ctx, key)
+}
+
+func (c *memDBClient) Del(ctx context.Context, keys ...string) (err error) {
+	ctx, span := start(ctx, "Del", keys...)
+	defer func() { end(span, err) }()
+
+	return c.cli.Del(ctx, keys...)
+}
+
+func (c *memDBClient) SAdd(ctx context.Context, key string, values ...interface{}) (err error) {
+	ctx, span := start(ctx, "SAdd", key)
+	defer func() { end(span, err) }()
+
+	return c.cli.SAdd(ctx, key, values...)
+}
+
+func (c *memDBClient) SRem(ctx context.Context, key string, members ...interface{}) (err error) {
+	ctx, span := start(ctx, "SRem", key)
+	defer func() { end(span, err) }()
+
+	return c.cli.SRem(ctx, key, members...)
+}
+
+func (c *memDBClient) SMembers(ctx context.Context, key string) (_ []string, err error) {
+	ctx, span := start(ctx, "SMembers", key)
+	defer func() { end(span, err) }()
+
+	return c.cli.SMembers(ctx, key)
+}
+
+func (c *memDBClient) PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) (err error) {
+	ctx, span := start(ctx, "PublishStream", streamKey)
+	defer func() { end(span, err) }()
+
+	return c.cli.PublishStream(ctx, streamKey, messages)
+}
+
+func (c *memDBClient) PublishStreamIfLatest(ctx context.Context, streamKey, latestID string, messages map[string]interface{}) (err error) {
+	ctx, span := start(ctx, "PublishStreamIfLatest", streamKey)
+	defer func() { end(span, err) }()
+
+	return c.cli.PublishStreamIfLatest(ctx, streamKey, latestID, messages)
+}
+
+func (c *memDBClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (_, _ string, err error) {
+	ctx, span := start(ctx, "ReadStream", streamKey)
+	defer func() { end(span, err) }()
+
+	return c.cli.ReadStream(ctx, streamKey, messageKey, previousID)
+}
+
+func (c *memDBClient) ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (_, _ string, err error) {
+	ctx, span := start(ctx, "ReadStreamLatest", streamKey)
+	defer func() { end(span, err) }()
+
+	return c.cli.ReadStreamLatest(ctx, streamKey, messageKey)
+}
+
+func (c *memDBClient) ReadStreamRange(ctx context.Context, streamKey, messageKey string) (_ []gateways.StreamMessage, err error) {
+	ctx, span := start(ctx, "ReadStreamRange", streamKey)
+	defer func() { end(span, err) }()
+
+	return c.cli.ReadStreamRange(ctx, streamKey, messageKey)
+}
+
+func (c *memDBClient) Expire(ctx context.Context, key string, duration time.Duration) (err error) {
+	ctx, span := start(ctx, "Expire", key)
+	defer func() { end(span, err) }()
+
+	return c.cli.Expire(ctx, key, duration)
+}
@@ -0,0 +1,64 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/memory"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+	return recorder
+}
+
+func TestTraceMemDBClient(t *testing.T) {
+	recorder := recordSpans(t)
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	cli := TraceMemDBClient(memory.NewMemoryClient(gateways.StreamRetention{MaxLen: 1}))
+
+	if err := cli.Set(ctx, "key", "value", time.Minute); err != nil {
+		t.Fatalf("failed to Set: %v", err)
+	}
+	if _, err := cli.Get(ctx, "missing"); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError, actual %v", err)
+	}
+	if err := cli.PublishStreamIfLatest(ctx, "stream", "1-1", map[string]interface{}{"m": "1"}); !errs.IsConflictError(err) {
+		t.Errorf("expected ConflictError, actual %v", err)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans, actual %d", len(spans))
+	}
+	for i, tc := range []struct {
+		name   string
+		status codes.Code
+	}{
+		{"MemDBClient.Set", codes.Unset},
+		{"MemDBClient.Get", codes.Unset},
+		{"MemDBClient.PublishStreamIfLatest", codes.Error},
+	} {
+		span := spans[i]
+		if span.Name() != tc.name {
+			t.Errorf("expected %s, actual %s", tc.name, span.Name())
+		}
+		if span.Status().Code != tc.status {
+			t.Errorf("%s: expected status %v, actual %v", tc.name, tc.status, span.Status().Code)
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("%s: expected to be a child of the parent span", tc.name)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"golang.org/x/xerrors"
+)
+
+type (
+	// Shutdown flushes the remaining spans and stops the exporter.
+	Shutdown func(ctx context.Context) error
+)
+
+// Setup registers the global tracer provider and propagator by config.
+func Setup(ctx context.Context, config Config) (Shutdown, error) {
+	if config.Exporter == ExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, config)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to newExporter: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(config.ServiceName),
+		)),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return tp.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, config Config) (sdktrace.SpanExporter, error) {
+	switch config.Exporter {
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+		if config.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to create otlp exporter: %w", err)
+		}
+		return exporter, nil
+	case ExporterFile:
+		f, err := os.OpenFile(config.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to open %s: %w", config.FilePath, err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, xerrors.Errorf("failed to create file exporter: %w", err)
+		}
+		return &fileExporter{SpanExporter: exporter, file: f}, nil
+	default:
+		return nil, xerrors.Errorf("unknown exporter: %s", config.Exporter)
+	}
+}
+
+type (
+	// fileExporter closes the file after the spans are flushed.
+	fileExporter struct {
+		sdktrace.SpanExporter
+		file *os.File
+	}
+)
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	if err := e.SpanExporter.Shutdown(ctx); err != nil {
+		return xerrors.Errorf("failed to shutdown exporter: %w", err)
+	}
+	if err := e.file.Close(); err != nil {
+		return xerrors.Errorf("failed to close %s: %w", e.file.Name(), err)
+	}
+	return nil
+}
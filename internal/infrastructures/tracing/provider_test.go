@@ -0,0 +1,50 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/swallowarc/porker-rpc/internal/commons/tracers"
+	"go.opentelemetry.io/otel"
+)
+
+func TestSetup_File(t *testing.T) {
+	original := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	path := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := Setup(context.Background(), Config{
+		Exporter:    ExporterFile,
+		ServiceName: "porker-test",
+		FilePath:    path,
+		SampleRatio: 1,
+	})
+	if err != nil {
+		t.Fatalf("failed to Setup: %v", err)
+	}
+
+	_, span := tracers.Start(context.Background(), "test", tracers.RoomID("12345"), tracers.LoginID("alice"))
+	span.End()
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("failed to shutdown: %v", err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+	for _, expected := range []string{`"Name":"test"`, `"room_id"`, `"12345"`, `"login_id"`, `"porker-test"`} {
+		if !bytes.Contains(b, []byte(expected)) {
+			t.Errorf("expected %s in the exported span: %s", expected, b)
+		}
+	}
+}
+
+func TestSetup_UnknownExporter(t *testing.T) {
+	if _, err := Setup(context.Background(), Config{Exporter: "unknown"}); err == nil {
+		t.Error("expected error by unknown exporter")
+	}
+}
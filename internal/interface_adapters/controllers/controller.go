@@ -1,7 +1,11 @@
 package controllers
 
 import (
+	"context"
+
 	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/auth"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
 	"github.com/swallowarc/porker-rpc/internal/usecases/interactors"
 	"go.uber.org/zap"
 )
@@ -23,3 +27,13 @@ func NewPorkerController(logger *zap.Logger, iFactory interactors.Factory) porke
 		pokerInteractor: iFactory.PokerInteractor(),
 	}
 }
+
+// authenticatedLogin returns the login authenticated by the interceptor.
+// Login IDs in requests are ignored so that nobody can act as another login.
+func authenticatedLogin(ctx context.Context) (*porker.Login, error) {
+	login, ok := auth.Login(ctx)
+	if !ok {
+		return nil, errs.NewUnauthenticatedError("login is not authenticated")
+	}
+	return login, nil
+}
@@ -2,10 +2,8 @@ package controllers
 
 import (
 	"context"
-	"time"
 
 	"github.com/swallowarc/porker-proto/pkg/porker"
-	"github.com/swallowarc/porker-rpc/internal/commons/errs"
 	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
 	"github.com/swallowarc/porker-rpc/internal/domains/room"
 	"github.com/swallowarc/porker-rpc/internal/usecases/listener"
@@ -29,8 +27,11 @@ func (c *porkerController) Login(ctx context.Context, request *porker.LoginReque
 	}, nil
 }
 
-func (c *porkerController) Logout(ctx context.Context, request *porker.LogoutRequest) (*porker.NoBody, error) {
-	login := request.Login
+func (c *porkerController) Logout(ctx context.Context, _ *porker.LogoutRequest) (*porker.NoBody, error) {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
 	loggers.With(ctx, loggers.Map{
 		"login_id":   login.LoginId,
 		"session_id": login.SessionId,
@@ -42,7 +43,12 @@ func (c *porkerController) Logout(ctx context.Context, request *porker.LogoutReq
 }
 
 func (c *porkerController) CreateRoom(ctx context.Context, req *porker.CreateRoomRequest) (*porker.CreateRoomResponse, error) {
-	roomID, err := c.pokerInteractor.Create(ctx, req.LoginId)
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roomID, err := c.pokerInteractor.Create(ctx, login.LoginId, req.GetDeck())
 	if err != nil {
 		return nil, xerrors.Errorf("failed to Create: %w", err)
 	}
@@ -63,55 +69,74 @@ func (c *porkerController) CanEnterRoom(ctx context.Context, req *porker.CanEnte
 
 func (c *porkerController) EnterRoom(request *porker.EnterRoomRequest, stream porker.PorkerService_EnterRoomServer) error {
 	ctx := loggers.LoggerToContext(stream.Context(), c.logger)
-	lsnr, err := c.pokerInteractor.Enter(ctx, room.ID(request.RoomId), request.LoginId)
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return err
+	}
+
+	lsnr, err := c.pokerInteractor.Enter(ctx, room.ID(request.RoomId), login.LoginId, request.Role)
 	if err != nil {
 		return xerrors.Errorf("failed to Enter: %w", err)
 	}
 
 	for {
-		select {
-		case <-ctx.Done():
-			return nil
-		default:
-			bt, err := lsnr.Listen(ctx)
-			if err != nil {
-				if errs.IsNotFoundError(err) {
-					time.Sleep(time.Second)
-					continue
-				}
-				if xerrors.As(err, &listener.LeftError) {
-					loggers.Logger(ctx).Info("already left the room")
-					return nil
-				}
-				if xerrors.As(err, &context.Canceled) {
-					loggers.Logger(ctx).Info("context canceled")
-					return nil
-				}
-
-				return xerrors.Errorf("failed to Listen: %w", err)
+		bt, err := lsnr.Listen(ctx)
+		if err != nil {
+			if xerrors.Is(err, listener.LeftError) {
+				loggers.Logger(ctx).Info("already left the room")
+				return nil
+			}
+			if xerrors.Is(err, context.Canceled) {
+				loggers.Logger(ctx).Info("context canceled")
+				return nil
 			}
-			if err := stream.Send(bt); err != nil {
-				if xerrors.As(err, &context.Canceled) {
-					loggers.Logger(ctx).Debug("client context canceled")
-					return nil
-				}
 
-				return xerrors.Errorf("failed to Send: %w", err)
+			return xerrors.Errorf("failed to Listen: %w", err)
+		}
+		if err := stream.Send(bt); err != nil {
+			if xerrors.Is(err, context.Canceled) {
+				loggers.Logger(ctx).Debug("client context canceled")
+				return nil
 			}
+
+			return xerrors.Errorf("failed to Send: %w", err)
 		}
 	}
 }
 
 func (c *porkerController) LeaveRoom(ctx context.Context, req *porker.LeaveRoomRequest) (*porker.NoBody, error) {
-	if err := c.pokerInteractor.Leave(ctx, room.ID(req.RoomId), req.LoginId); err != nil {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pokerInteractor.Leave(ctx, room.ID(req.RoomId), login.LoginId); err != nil {
 		return nil, xerrors.Errorf("failed to Leave: %w", err)
 	}
 
 	return &porker.NoBody{}, nil
 }
 
+func (c *porkerController) ChangeRole(ctx context.Context, req *porker.ChangeRoleRequest) (*porker.NoBody, error) {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pokerInteractor.ChangeRole(ctx, room.ID(req.RoomId), login.LoginId, req.MemberLoginId, req.Role); err != nil {
+		return nil, xerrors.Errorf("failed to ChangeRole: %w", err)
+	}
+
+	return &porker.NoBody{}, nil
+}
+
 func (c *porkerController) Voting(ctx context.Context, req *porker.VotingRequest) (*porker.NoBody, error) {
-	if err := c.pokerInteractor.Voting(ctx, room.ID(req.RoomId), req.Ballot.LoginId, req.Ballot.Point); err != nil {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pokerInteractor.Voting(ctx, room.ID(req.RoomId), login.LoginId, req.GetBallot().GetPoint(), req.GetBallot().GetCard()); err != nil {
 		return nil, xerrors.Errorf("failed to Voting: %w", err)
 	}
 
@@ -119,17 +144,108 @@ func (c *porkerController) Voting(ctx context.Context, req *porker.VotingRequest
 }
 
 func (c *porkerController) VoteCounting(ctx context.Context, req *porker.VoteCountingRequest) (*porker.NoBody, error) {
-	if err := c.pokerInteractor.VoteCounting(ctx, room.ID(req.RoomId), req.LoginId); err != nil {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pokerInteractor.VoteCounting(ctx, room.ID(req.RoomId), login.LoginId); err != nil {
 		return nil, xerrors.Errorf("failed to Pick: %w", err)
 	}
 
 	return &porker.NoBody{}, nil
 }
 
+func (c *porkerController) StartTimer(ctx context.Context, req *porker.StartTimerRequest) (*porker.NoBody, error) {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pokerInteractor.StartTimer(ctx, room.ID(req.RoomId), login.LoginId, req.GetDuration().AsDuration()); err != nil {
+		return nil, xerrors.Errorf("failed to StartTimer: %w", err)
+	}
+
+	return &porker.NoBody{}, nil
+}
+
 func (c *porkerController) ResetRoom(ctx context.Context, req *porker.ResetRoomRequest) (*porker.NoBody, error) {
-	if err := c.pokerInteractor.Reset(ctx, room.ID(req.RoomId)); err != nil {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pokerInteractor.Reset(ctx, room.ID(req.RoomId), login.LoginId, req.Estimate); err != nil {
 		return nil, xerrors.Errorf("failed to Reset: %w", err)
 	}
 
 	return &porker.NoBody{}, nil
 }
+
+func (c *porkerController) AddStory(ctx context.Context, req *porker.AddStoryRequest) (*porker.AddStoryResponse, error) {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := c.pokerInteractor.AddStory(ctx, room.ID(req.RoomId), login.LoginId, req.GetStory())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to AddStory: %w", err)
+	}
+
+	return &porker.AddStoryResponse{Story: s}, nil
+}
+
+func (c *porkerController) MoveStory(ctx context.Context, req *porker.MoveStoryRequest) (*porker.NoBody, error) {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pokerInteractor.MoveStory(ctx, room.ID(req.RoomId), login.LoginId, req.StoryId, int(req.Index)); err != nil {
+		return nil, xerrors.Errorf("failed to MoveStory: %w", err)
+	}
+
+	return &porker.NoBody{}, nil
+}
+
+func (c *porkerController) RemoveStory(ctx context.Context, req *porker.RemoveStoryRequest) (*porker.NoBody, error) {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.pokerInteractor.RemoveStory(ctx, room.ID(req.RoomId), login.LoginId, req.StoryId); err != nil {
+		return nil, xerrors.Errorf("failed to RemoveStory: %w", err)
+	}
+
+	return &porker.NoBody{}, nil
+}
+
+func (c *porkerController) ListRoomHistory(ctx context.Context, req *porker.ListRoomHistoryRequest) (*porker.ListRoomHistoryResponse, error) {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots, err := c.pokerInteractor.History(ctx, room.ID(req.RoomId), login.LoginId)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to History: %w", err)
+	}
+
+	return &porker.ListRoomHistoryResponse{Snapshots: snapshots}, nil
+}
+
+func (c *porkerController) ListArchivedRounds(ctx context.Context, req *porker.ListArchivedRoundsRequest) (*porker.ListArchivedRoundsResponse, error) {
+	login, err := authenticatedLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds, err := c.pokerInteractor.ArchivedRounds(ctx, room.ID(req.RoomId), login.LoginId)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ArchivedRounds: %w", err)
+	}
+
+	return &porker.ListArchivedRoundsResponse{Rounds: rounds}, nil
+}
@@ -0,0 +1,273 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/usecases/interactors"
+	"github.com/swallowarc/porker-rpc/internal/usecases/listener"
+	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"nhooyr.io/websocket"
+)
+
+const (
+	roomFeedPathPrefix = "/v1/rooms/"
+	loginIDHeader      = "Porker-Login-Id"
+	sessionIDHeader    = "Porker-Session-Id"
+	// EventSource and WebSocket of browsers cannot send headers, so they give a ticket issued with the headers instead.
+	ticketQuery       = "ticket"
+	sseEventName      = "situation"
+	keepAliveInterval = 30 * time.Second
+
+	feedKindEvents  = "events"
+	feedKindWS      = "ws"
+	feedKindTickets = "tickets"
+)
+
+type (
+	roomFeedController struct {
+		logger         *zap.Logger
+		allowedOrigins []string
+
+		loginInteractor interactors.LoginInteractor
+		pokerInteractor interactors.PokerInteractor
+	}
+)
+
+// NewRoomFeedController returns a handler streaming the situations of a room without entering it.
+//
+//	GET /v1/rooms/{room_id}/events streams them as Server-Sent Events.
+//	GET /v1/rooms/{room_id}/ws streams them as WebSocket text messages.
+//	POST /v1/rooms/{room_id}/tickets returns a short-lived ticket of the login for the two above.
+//
+// Each situation is encoded in the protobuf JSON mapping.
+// The login is given by headers, or by the ticket query parameter for browsers.
+func NewRoomFeedController(logger *zap.Logger, iFactory interactors.Factory, allowedOrigins []string) http.Handler {
+	return &roomFeedController{
+		logger:          logger,
+		allowedOrigins:  allowedOrigins,
+		loginInteractor: iFactory.LoginInteractor(),
+		pokerInteractor: iFactory.PokerInteractor(),
+	}
+}
+
+func (c *roomFeedController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	roomID, kind, ok := parseRoomFeedPath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	origin := r.Header.Get("Origin")
+	if origin != "" {
+		if !c.allowOrigin(origin) {
+			http.Error(w, "origin is not allowed", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Vary", "Origin")
+	}
+
+	if kind == feedKindTickets {
+		c.serveTicket(w, r, roomID)
+		return
+	}
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := loggers.LoggerToContext(r.Context(), c.logger.With(zap.String("room_id", roomID.String())))
+	lsnr, err := c.watch(ctx, r, roomID)
+	if err != nil {
+		c.writeError(ctx, w, err)
+		return
+	}
+
+	switch kind {
+	case feedKindEvents:
+		err = c.serveSSE(ctx, w, lsnr)
+	case feedKindWS:
+		err = c.serveWebSocket(ctx, w, r, lsnr)
+	}
+	if err != nil {
+		loggers.Logger(ctx).Warn("room feed stopped", zap.String("kind", kind), zap.Error(err))
+	}
+}
+
+func parseRoomFeedPath(path string) (room.ID, string, bool) {
+	if !strings.HasPrefix(path, roomFeedPathPrefix) {
+		return "", "", false
+	}
+	parts := strings.Split(strings.TrimPrefix(path, roomFeedPathPrefix), "/")
+	if len(parts) != 2 || parts[0] == "" || (parts[1] != feedKindEvents && parts[1] != feedKindWS && parts[1] != feedKindTickets) {
+		return "", "", false
+	}
+	return room.ID(parts[0]), parts[1], true
+}
+
+func (c *roomFeedController) allowOrigin(origin string) bool {
+	for _, o := range c.allowedOrigins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *roomFeedController) serveTicket(w http.ResponseWriter, r *http.Request, roomID room.ID) {
+	switch r.Method {
+	case http.MethodOptions:
+		// preflight of browsers sending the login headers
+		w.Header().Set("Access-Control-Allow-Methods", http.MethodPost)
+		w.Header().Set("Access-Control-Allow-Headers", loginIDHeader+", "+sessionIDHeader)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case http.MethodPost:
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := loggers.LoggerToContext(r.Context(), c.logger.With(zap.String("room_id", roomID.String())))
+	ticket, err := c.loginInteractor.IssueFeedTicket(ctx, roomID, headerLogin(r))
+	if err != nil {
+		c.writeError(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if err := json.NewEncoder(w).Encode(map[string]string{"ticket": ticket}); err != nil {
+		loggers.Logger(ctx).Warn("failed to write the ticket", zap.Error(err))
+	}
+}
+
+func (c *roomFeedController) watch(ctx context.Context, r *http.Request, roomID room.ID) (ports.PokerListener, error) {
+	if ticket := r.URL.Query().Get(ticketQuery); ticket != "" {
+		if _, err := c.loginInteractor.UseFeedTicket(ctx, roomID, ticket); err != nil {
+			return nil, xerrors.Errorf("failed to UseFeedTicket: %w", err)
+		}
+	} else if err := c.loginInteractor.Authenticate(ctx, headerLogin(r)); err != nil {
+		return nil, xerrors.Errorf("failed to Authenticate: %w", err)
+	}
+
+	lsnr, err := c.pokerInteractor.Watch(ctx, roomID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to Watch: %w", err)
+	}
+	return lsnr, nil
+}
+
+func (c *roomFeedController) writeError(ctx context.Context, w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errs.IsUnauthenticatedError(err):
+		code = http.StatusUnauthorized
+	case errs.IsNotFoundError(err):
+		code = http.StatusNotFound
+	default:
+		loggers.Logger(ctx).Error("failed to watch the room", zap.Error(err))
+	}
+	http.Error(w, http.StatusText(code), code)
+}
+
+func (c *roomFeedController) serveSSE(ctx context.Context, w http.ResponseWriter, lsnr ports.PokerListener) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return xerrors.New("http.ResponseWriter does not support flushing")
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	return listen(ctx, lsnr, func(b []byte) error {
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", sseEventName, b); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	}, func() error {
+		// comments keep proxies from closing the idle connection
+		if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+			return err
+		}
+		flusher.Flush()
+		return nil
+	})
+}
+
+func (c *roomFeedController) serveWebSocket(ctx context.Context, w http.ResponseWriter, r *http.Request, lsnr ports.PokerListener) error {
+	// the origin has already been checked
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{InsecureSkipVerify: true})
+	if err != nil {
+		return xerrors.Errorf("failed to Accept: %w", err)
+	}
+	defer conn.Close(websocket.StatusInternalError, "")
+
+	// the feed is one way, so only control frames are read until the client goes away
+	ctx = conn.CloseRead(ctx)
+
+	err = listen(ctx, lsnr, func(b []byte) error {
+		return conn.Write(ctx, websocket.MessageText, b)
+	}, func() error {
+		return conn.Ping(ctx)
+	})
+	if err != nil {
+		return err
+	}
+	return conn.Close(websocket.StatusNormalClosure, "room closed")
+}
+
+// listen sends the situations until the client goes away or nobody is in the room anymore.
+// keepAlive is called when nothing has been sent for a while.
+func listen(ctx context.Context, lsnr ports.PokerListener, send func(b []byte) error, keepAlive func() error) error {
+	for {
+		listenCtx, cancel := context.WithTimeout(ctx, keepAliveInterval)
+		ps, err := lsnr.Listen(listenCtx)
+		cancel()
+
+		switch {
+		case err == nil:
+		case xerrors.Is(err, listener.LeftError), ctx.Err() != nil:
+			return nil
+		case xerrors.Is(err, context.DeadlineExceeded):
+			if err := keepAlive(); err != nil {
+				// the client has gone away
+				return nil
+			}
+			continue
+		default:
+			return xerrors.Errorf("failed to Listen: %w", err)
+		}
+
+		b, err := protojson.Marshal(ps)
+		if err != nil {
+			return xerrors.Errorf("failed to Marshal: %w", err)
+		}
+		if err := send(b); err != nil {
+			// the client has gone away
+			return nil
+		}
+	}
+}
+
+func headerLogin(r *http.Request) *porker.Login {
+	return &porker.Login{
+		LoginId:   r.Header.Get(loginIDHeader),
+		SessionId: r.Header.Get(sessionIDHeader),
+	}
+}
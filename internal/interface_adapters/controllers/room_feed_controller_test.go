@@ -0,0 +1,284 @@
+package controllers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	mock_interactors "github.com/swallowarc/porker-rpc/internal/tests/mocks/interactors"
+	"github.com/swallowarc/porker-rpc/internal/usecases/interactors"
+	"github.com/swallowarc/porker-rpc/internal/usecases/listener"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"nhooyr.io/websocket"
+)
+
+type (
+	fakeFactory struct {
+		loginInteractor interactors.LoginInteractor
+		pokerInteractor interactors.PokerInteractor
+	}
+
+	// fakeListener returns the situations in order and then leaves.
+	fakeListener struct {
+		ch chan *porker.PokerSituation
+	}
+
+	// loginMatcher compares logins with proto.Equal, because printing a message for a mismatch
+	// changes its internal state and breaks the reflect.DeepEqual of gomock.Eq.
+	loginMatcher struct {
+		login *porker.Login
+	}
+)
+
+func (m loginMatcher) Matches(x interface{}) bool {
+	login, ok := x.(*porker.Login)
+	return ok && proto.Equal(m.login, login)
+}
+
+func (m loginMatcher) String() string {
+	return "is equal to " + m.login.String()
+}
+
+func eqLogin(loginID, sessionID string) gomock.Matcher {
+	return loginMatcher{login: &porker.Login{LoginId: loginID, SessionId: sessionID}}
+}
+
+func (f fakeFactory) LoginInteractor() interactors.LoginInteractor {
+	return f.loginInteractor
+}
+
+func (f fakeFactory) PokerInteractor() interactors.PokerInteractor {
+	return f.pokerInteractor
+}
+
+func (f fakeFactory) Close() {}
+
+func newFakeListener(situations ...*porker.PokerSituation) *fakeListener {
+	ch := make(chan *porker.PokerSituation, len(situations))
+	for _, ps := range situations {
+		ch <- ps
+	}
+	close(ch)
+	return &fakeListener{ch: ch}
+}
+
+func (l *fakeListener) Listen(ctx context.Context) (*porker.PokerSituation, error) {
+	ps, ok := <-l.ch
+	if !ok {
+		return nil, listener.LeftError
+	}
+	return ps, nil
+}
+
+var situations = []*porker.PokerSituation{
+	{RoomId: "12345", State: porker.RoomState_ROOM_STATE_TURN_DOWN},
+	{RoomId: "12345", State: porker.RoomState_ROOM_STATE_OPEN},
+}
+
+func newRoomFeedServer(t *testing.T, setup func(li *mock_interactors.MockLoginInteractor, pi *mock_interactors.MockPokerInteractor)) string {
+	ctrl := gomock.NewController(t)
+	li := mock_interactors.NewMockLoginInteractor(ctrl)
+	pi := mock_interactors.NewMockPokerInteractor(ctrl)
+	setup(li, pi)
+
+	handler := NewRoomFeedController(zap.NewNop(), fakeFactory{loginInteractor: li, pokerInteractor: pi}, []string{"http://porker.example.com"})
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func expectWatch(li *mock_interactors.MockLoginInteractor, pi *mock_interactors.MockPokerInteractor) {
+	li.EXPECT().Authenticate(gomock.Any(), eqLogin("alice", "session")).Return(nil)
+	pi.EXPECT().Watch(gomock.Any(), room.ID("12345")).Return(newFakeListener(situations...), nil)
+}
+
+func TestRoomFeedController_SSE(t *testing.T) {
+	url := newRoomFeedServer(t, expectWatch)
+
+	req, err := http.NewRequest(http.MethodGet, url+"/v1/rooms/12345/events", nil)
+	if err != nil {
+		t.Fatalf("failed to NewRequest: %v", err)
+	}
+	req.Header.Set("Porker-Login-Id", "alice")
+	req.Header.Set("Porker-Session-Id", "session")
+	req.Header.Set("Origin", "http://porker.example.com")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to Do: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("expected text/event-stream, actual %s", ct)
+	}
+	if o := resp.Header.Get("Access-Control-Allow-Origin"); o != "http://porker.example.com" {
+		t.Errorf("expected the origin to be allowed, actual %s", o)
+	}
+
+	var received []*porker.PokerSituation
+	s := bufio.NewScanner(resp.Body)
+	for s.Scan() {
+		if !strings.HasPrefix(s.Text(), "data: ") {
+			continue
+		}
+		ps := &porker.PokerSituation{}
+		if err := protojson.Unmarshal([]byte(strings.TrimPrefix(s.Text(), "data: ")), ps); err != nil {
+			t.Fatalf("failed to Unmarshal: %v", err)
+		}
+		received = append(received, ps)
+	}
+	if len(received) != len(situations) || received[1].State != porker.RoomState_ROOM_STATE_OPEN {
+		t.Errorf("unexpected situations: %v", received)
+	}
+}
+
+func TestRoomFeedController_WebSocket(t *testing.T) {
+	url := newRoomFeedServer(t, func(li *mock_interactors.MockLoginInteractor, pi *mock_interactors.MockPokerInteractor) {
+		li.EXPECT().UseFeedTicket(gomock.Any(), room.ID("12345"), "ticket").
+			Return(&porker.Login{LoginId: "alice", SessionId: "session"}, nil)
+		pi.EXPECT().Watch(gomock.Any(), room.ID("12345")).Return(newFakeListener(situations...), nil)
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(url, "http")+"/v1/rooms/12345/ws?ticket=ticket", nil)
+	if err != nil {
+		t.Fatalf("failed to Dial: %v", err)
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "")
+
+	for _, expected := range situations {
+		typ, b, err := conn.Read(ctx)
+		if err != nil {
+			t.Fatalf("failed to Read: %v", err)
+		}
+		ps := &porker.PokerSituation{}
+		if err := protojson.Unmarshal(b, ps); typ != websocket.MessageText || err != nil {
+			t.Fatalf("failed to Unmarshal %s message: %v", typ, err)
+		}
+		if ps.State != expected.State {
+			t.Errorf("expected %s, actual %s", expected.State, ps.State)
+		}
+	}
+
+	if _, _, err := conn.Read(ctx); websocket.CloseStatus(err) != websocket.StatusNormalClosure {
+		t.Errorf("expected normal closure after the room was closed, actual %v", err)
+	}
+}
+
+func TestRoomFeedController_Ticket(t *testing.T) {
+	url := newRoomFeedServer(t, func(li *mock_interactors.MockLoginInteractor, pi *mock_interactors.MockPokerInteractor) {
+		li.EXPECT().IssueFeedTicket(gomock.Any(), room.ID("12345"), eqLogin("alice", "session")).Return("ticket", nil)
+	})
+
+	// browsers check the login headers with a preflight request
+	req, err := http.NewRequest(http.MethodOptions, url+"/v1/rooms/12345/tickets", nil)
+	if err != nil {
+		t.Fatalf("failed to NewRequest: %v", err)
+	}
+	req.Header.Set("Origin", "http://porker.example.com")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to Do: %v", err)
+	}
+	_ = resp.Body.Close()
+	if h := resp.Header.Get("Access-Control-Allow-Headers"); !strings.Contains(h, "Porker-Session-Id") {
+		t.Errorf("expected the session header to be allowed, actual %q", h)
+	}
+
+	req, err = http.NewRequest(http.MethodPost, url+"/v1/rooms/12345/tickets", nil)
+	if err != nil {
+		t.Fatalf("failed to NewRequest: %v", err)
+	}
+	req.Header.Set("Porker-Login-Id", "alice")
+	req.Header.Set("Porker-Session-Id", "session")
+	req.Header.Set("Origin", "http://porker.example.com")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to Do: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Ticket string `json:"ticket"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to Decode: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || body.Ticket != "ticket" {
+		t.Errorf("expected the ticket, actual %d %q", resp.StatusCode, body.Ticket)
+	}
+}
+
+func TestRoomFeedController_Errors(t *testing.T) {
+	url := newRoomFeedServer(t, func(li *mock_interactors.MockLoginInteractor, pi *mock_interactors.MockPokerInteractor) {
+		li.EXPECT().Authenticate(gomock.Any(), eqLogin("", "")).
+			Return(errs.NewUnauthenticatedError("login does not exist")).Times(2)
+		li.EXPECT().Authenticate(gomock.Any(), eqLogin("alice", "wrong")).
+			Return(errs.NewUnauthenticatedError("session mismatch"))
+		li.EXPECT().Authenticate(gomock.Any(), eqLogin("alice", "session")).Return(nil)
+		li.EXPECT().UseFeedTicket(gomock.Any(), room.ID("12345"), "used").
+			Return(nil, errs.NewUnauthenticatedError("ticket is not valid"))
+		pi.EXPECT().Watch(gomock.Any(), room.ID("99999")).Return(nil, errs.NewNotFoundError("room does not exist"))
+	})
+
+	for name, tc := range map[string]struct {
+		path, origin, loginID, sessionID string
+		expected                         int
+	}{
+		"no login":         {path: "/v1/rooms/12345/events", expected: http.StatusUnauthorized},
+		"wrong session":    {path: "/v1/rooms/12345/events", loginID: "alice", sessionID: "wrong", expected: http.StatusUnauthorized},
+		"session in query": {path: "/v1/rooms/12345/events?login_id=alice&session_id=session", expected: http.StatusUnauthorized},
+		"used ticket":      {path: "/v1/rooms/12345/events?ticket=used", expected: http.StatusUnauthorized},
+		"missing room":     {path: "/v1/rooms/99999/events", loginID: "alice", sessionID: "session", expected: http.StatusNotFound},
+		"unknown path":     {path: "/v1/rooms/12345/unknown", expected: http.StatusNotFound},
+		"forbidden origin": {path: "/v1/rooms/12345/events", origin: "http://evil.example.com", expected: http.StatusForbidden},
+	} {
+		req, err := http.NewRequest(http.MethodGet, url+tc.path, nil)
+		if err != nil {
+			t.Fatalf("failed to NewRequest: %v", err)
+		}
+		if tc.origin != "" {
+			req.Header.Set("Origin", tc.origin)
+		}
+		if tc.loginID != "" {
+			req.Header.Set("Porker-Login-Id", tc.loginID)
+			req.Header.Set("Porker-Session-Id", tc.sessionID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("failed to Do: %v", err)
+		}
+		_ = resp.Body.Close()
+		if resp.StatusCode != tc.expected {
+			t.Errorf("%s: expected %d, actual %d", name, tc.expected, resp.StatusCode)
+		}
+	}
+}
+
+func TestRoomFeedController_NoAllowedOrigins(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	handler := NewRoomFeedController(zap.NewNop(), fakeFactory{
+		loginInteractor: mock_interactors.NewMockLoginInteractor(ctrl),
+		pokerInteractor: mock_interactors.NewMockPokerInteractor(ctrl),
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/rooms/12345/tickets", nil)
+	req.Header.Set("Origin", "http://porker.example.com")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("expected browsers to be forbidden without allowed origins, actual %d", rec.Code)
+	}
+}
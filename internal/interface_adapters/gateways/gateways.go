@@ -3,5 +3,10 @@ package gateways
 type (
 	Factory interface {
 		MemDBClient() MemDBClient
+		StreamClient() StreamClient
+		// RDBClient returns nil if the archive is disabled.
+		RDBClient() RDBClient
+		// Close closes all clients created by the factory.
+		Close() error
 	}
 )
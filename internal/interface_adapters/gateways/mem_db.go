@@ -7,18 +7,40 @@ import (
 )
 
 type (
+	// StreamRetention decides how many messages a stream keeps.
+	// MaxLen keeps the last N messages, and MaxAge additionally drops messages older than it when it is positive.
+	// The latest message is always kept.
+	StreamRetention struct {
+		MaxLen int64
+		MaxAge time.Duration
+	}
+
+	StreamMessage struct {
+		ID          string
+		Message     string
+		PublishedAt time.Time
+	}
+
 	MemDBClient interface {
 		Ping(ctx context.Context) error
+		Close() error
 		Set(ctx context.Context, key string, value interface{}, duration time.Duration) error
 		SetNX(ctx context.Context, key string, value interface{}, duration time.Duration) error
 		Get(ctx context.Context, key string) (string, error)
-		Del(ctx context.Context, key string) error
+		// Del deletes keys. In Redis Cluster all keys must be in the same hash slot.
+		Del(ctx context.Context, keys ...string) error
 		SAdd(ctx context.Context, key string, values ...interface{}) error
 		SRem(ctx context.Context, key string, members ...interface{}) error
 		SMembers(ctx context.Context, key string) ([]string, error)
 		PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) error
+		// PublishStreamIfLatest publishes messages only if the latest message ID of the stream is still latestID.
+		// An empty latestID means the stream must not have any message yet.
+		// It returns errs.ConflictError when the stream has been updated by someone else.
+		PublishStreamIfLatest(ctx context.Context, streamKey, latestID string, messages map[string]interface{}) error
 		ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error)
 		ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (id, message string, err error)
+		// ReadStreamRange returns all messages retained in the stream, oldest first.
+		ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]StreamMessage, error)
 		Expire(ctx context.Context, key string, duration time.Duration) error
 	}
 )
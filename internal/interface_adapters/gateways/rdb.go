@@ -0,0 +1,17 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/mocks/$GOPACKAGE/mock_$GOFILE -package=mock_$GOPACKAGE
+package gateways
+
+import (
+	"context"
+	"database/sql"
+)
+
+type (
+	// RDBClient is a relational database. *sql.DB satisfies it.
+	RDBClient interface {
+		ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+		QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+		BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+		Close() error
+	}
+)
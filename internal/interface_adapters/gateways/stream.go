@@ -0,0 +1,25 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/mocks/$GOPACKAGE/mock_$GOFILE -package=mock_$GOPACKAGE
+package gateways
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// StreamClient is the transport of room streams. MemDBClient satisfies it, so the mem db is used by default.
+	// Message IDs are opaque to callers and only compared by the implementation.
+	StreamClient interface {
+		PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) error
+		// PublishStreamIfLatest publishes messages only if the latest message ID of the stream is still latestID.
+		// An empty latestID means the stream must not have any message yet.
+		// It returns errs.ConflictError when the stream has been updated by someone else.
+		PublishStreamIfLatest(ctx context.Context, streamKey, latestID string, messages map[string]interface{}) error
+		ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (id, message string, err error)
+		ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (id, message string, err error)
+		// ReadStreamRange returns all messages retained in the stream, oldest first.
+		ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]StreamMessage, error)
+		Expire(ctx context.Context, key string, duration time.Duration) error
+		Del(ctx context.Context, keys ...string) error
+	}
+)
@@ -0,0 +1,163 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/domains/round"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+const (
+	selectRoundsQuery = `
+SELECT r.id, r.room_id, r.story, r.started_at, r.revealed_at, b.login_id, b.point, b.card
+FROM rounds r LEFT JOIN ballots b ON b.round_id = r.id
+WHERE %s
+ORDER BY r.revealed_at, r.id, b.seq`
+)
+
+type (
+	archiveRepository struct {
+		rdbCli gateways.RDBClient
+	}
+
+	// disabledArchiveRepository does not record rounds, for deployments without the rdb.
+	disabledArchiveRepository struct{}
+)
+
+// NewArchiveRepository returns a repository that does not record rounds if the gateways have no rdb client.
+func NewArchiveRepository(gwFactory gateways.Factory) ports.ArchiveRepository {
+	rdbCli := gwFactory.RDBClient()
+	if rdbCli == nil {
+		return disabledArchiveRepository{}
+	}
+	return &archiveRepository{
+		rdbCli: rdbCli,
+	}
+}
+
+func (r *archiveRepository) Save(ctx context.Context, rd *round.Round) (err error) {
+	tx, err := r.rdbCli.BeginTx(ctx, nil)
+	if err != nil {
+		return xerrors.Errorf("failed to BeginTx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO rounds (room_id, story, started_at, revealed_at) VALUES (?, ?, ?, ?)`,
+		rd.RoomID.String(), rd.Story, toUnixMilli(rd.StartedAt), toUnixMilli(rd.RevealedAt))
+	if err != nil {
+		return xerrors.Errorf("failed to insert round: %w", err)
+	}
+	roundID, err := res.LastInsertId()
+	if err != nil {
+		return xerrors.Errorf("failed to LastInsertId: %w", err)
+	}
+
+	for i, b := range rd.Ballots {
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO ballots (round_id, seq, login_id, point, card) VALUES (?, ?, ?, ?, ?)`,
+			roundID, i, b.LoginId, b.Point.String(), b.Card); err != nil {
+			return xerrors.Errorf("failed to insert ballot: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return xerrors.Errorf("failed to Commit: %w", err)
+	}
+	return nil
+}
+
+func (r *archiveRepository) ListByRoom(ctx context.Context, roomID room.ID) ([]*round.Round, error) {
+	rounds, err := r.list(ctx, "r.room_id = ?", roomID.String())
+	if err != nil {
+		return nil, xerrors.Errorf("failed to list: %w", err)
+	}
+	return rounds, nil
+}
+
+func (r *archiveRepository) list(ctx context.Context, where string, args ...interface{}) ([]*round.Round, error) {
+	rows, err := r.rdbCli.QueryContext(ctx, fmt.Sprintf(selectRoundsQuery, where), args...)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to QueryContext: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		rounds []*round.Round
+		lastID int64
+	)
+	for rows.Next() {
+		var (
+			id         int64
+			roomID     string
+			story      string
+			startedAt  int64
+			revealedAt int64
+			loginID    sql.NullString
+			point      sql.NullString
+			card       sql.NullString
+		)
+		if err := rows.Scan(&id, &roomID, &story, &startedAt, &revealedAt, &loginID, &point, &card); err != nil {
+			return nil, xerrors.Errorf("failed to Scan: %w", err)
+		}
+
+		if len(rounds) == 0 || id != lastID {
+			rounds = append(rounds, &round.Round{
+				RoomID:     room.ID(roomID),
+				Story:      story,
+				Ballots:    []*porker.Ballot{},
+				StartedAt:  fromUnixMilli(startedAt),
+				RevealedAt: fromUnixMilli(revealedAt),
+			})
+			lastID = id
+		}
+		if loginID.Valid {
+			rd := rounds[len(rounds)-1]
+			rd.Ballots = append(rd.Ballots, &porker.Ballot{
+				LoginId: loginID.String,
+				Point:   porker.Point(porker.Point_value[point.String]),
+				Card:    card.String,
+			})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, xerrors.Errorf("failed to iterate rows: %w", err)
+	}
+
+	return rounds, nil
+}
+
+// toUnixMilli stores the zero time as 0, which fromUnixMilli reads back as the zero time.
+func toUnixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromUnixMilli(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+func (disabledArchiveRepository) Save(context.Context, *round.Round) error {
+	return nil
+}
+
+func (disabledArchiveRepository) ListByRoom(context.Context, room.ID) ([]*round.Round, error) {
+	return nil, errs.NewInvalidStateError("the archive is disabled")
+}
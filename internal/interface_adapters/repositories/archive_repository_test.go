@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/domains/round"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/sqlite"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	rdbFactory struct {
+		rdbCli gateways.RDBClient
+	}
+)
+
+func (f rdbFactory) MemDBClient() gateways.MemDBClient {
+	return nil
+}
+
+func (f rdbFactory) StreamClient() gateways.StreamClient {
+	return nil
+}
+
+func (f rdbFactory) RDBClient() gateways.RDBClient {
+	return f.rdbCli
+}
+
+func (f rdbFactory) Close() error {
+	return nil
+}
+
+func TestArchiveRepository(t *testing.T) {
+	ctx := context.Background()
+	rdbCli, err := sqlite.NewSQLiteClient(ctx, sqlite.Config{Path: ":memory:"})
+	if err != nil {
+		t.Fatalf("failed to NewSQLiteClient: %v", err)
+	}
+	defer rdbCli.Close()
+	repo := NewArchiveRepository(rdbFactory{rdbCli: rdbCli})
+
+	base := time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i, ps := range []*porker.PokerSituation{
+		{RoomId: "11111", Ballots: []*porker.Ballot{{LoginId: "a", Point: porker.Point_POINT_CARD, Card: "XL"}, {LoginId: "b", Point: porker.Point_NOT_VOTE}}, RoundStartedAt: timestamppb.New(base.Add(-time.Minute))},
+		{RoomId: "11111", Ballots: []*porker.Ballot{{LoginId: "a", Point: porker.Point_POINT_8}}},
+		{RoomId: "22222", Ballots: []*porker.Ballot{}},
+	} {
+		if err := repo.Save(ctx, round.New(ps, base.Add(time.Duration(i)*time.Hour))); err != nil {
+			t.Fatalf("failed to Save: %v", err)
+		}
+	}
+
+	rounds, err := repo.ListByRoom(ctx, "11111")
+	if err != nil {
+		t.Fatalf("failed to ListByRoom: %v", err)
+	}
+	if len(rounds) != 2 {
+		t.Fatalf("expected 2 rounds, actual %d", len(rounds))
+	}
+	if b := rounds[0].Ballots; len(b) != 2 || b[0].Point != porker.Point_POINT_CARD || b[0].Card != "XL" || b[1].Point != porker.Point_NOT_VOTE {
+		t.Errorf("unexpected ballots: %v", b)
+	}
+	if !rounds[1].RevealedAt.Equal(base.Add(time.Hour)) {
+		t.Errorf("expected %v, actual %v", base.Add(time.Hour), rounds[1].RevealedAt)
+	}
+	if !rounds[0].StartedAt.Equal(base.Add(-time.Minute)) {
+		t.Errorf("expected %v, actual %v", base.Add(-time.Minute), rounds[0].StartedAt)
+	}
+	if !rounds[1].StartedAt.IsZero() {
+		t.Errorf("round without the start must have the zero time, actual %v", rounds[1].StartedAt)
+	}
+
+	rounds, err = repo.ListByRoom(ctx, "22222")
+	if err != nil {
+		t.Fatalf("failed to ListByRoom: %v", err)
+	}
+	if len(rounds) != 1 || len(rounds[0].Ballots) != 0 {
+		t.Errorf("unexpected rounds: %v", rounds)
+	}
+}
+
+func TestArchiveRepository_Disabled(t *testing.T) {
+	ctx := context.Background()
+	repo := NewArchiveRepository(rdbFactory{})
+
+	if err := repo.Save(ctx, round.New(&porker.PokerSituation{RoomId: "11111"}, time.Now())); err != nil {
+		t.Errorf("rounds must be dropped without error, actual %v", err)
+	}
+	if _, err := repo.ListByRoom(ctx, "11111"); !errs.IsInvalidStateError(err) {
+		t.Errorf("expected InvalidStateError, actual %v", err)
+	}
+}
@@ -1,21 +1,24 @@
 package repositories
 
 import (
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
 	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
 	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
 )
 
 type (
 	factory struct {
-		loginRepository ports.LoginRepository
-		pokerRepository ports.PokerRepository
+		loginRepository   ports.LoginRepository
+		pokerRepository   ports.PokerRepository
+		archiveRepository ports.ArchiveRepository
 	}
 )
 
-func NewFactory(gwFactory gateways.Factory) ports.RepositoriesFactory {
+func NewFactory(gwFactory gateways.Factory, situationFormat SituationFormat, roomKeys room.KeyStrategy) ports.RepositoriesFactory {
 	return &factory{
-		loginRepository: NewLoginRepository(gwFactory),
-		pokerRepository: NewPokerRepository(gwFactory),
+		loginRepository:   NewLoginRepository(gwFactory),
+		pokerRepository:   NewPokerRepository(gwFactory, situationFormat, roomKeys),
+		archiveRepository: NewArchiveRepository(gwFactory),
 	}
 }
 
@@ -26,3 +29,7 @@ func (f *factory) LoginRepository() ports.LoginRepository {
 func (f *factory) PokerRepository() ports.PokerRepository {
 	return f.pokerRepository
 }
+
+func (f *factory) ArchiveRepository() ports.ArchiveRepository {
+	return f.archiveRepository
+}
@@ -8,14 +8,21 @@ import (
 	"github.com/google/uuid"
 	"github.com/swallowarc/porker-proto/pkg/porker"
 	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
 	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
 	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
 	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
-	loginKeyPrefix = "porker_login"
-	loginTimeout   = time.Hour
+	loginKeyPrefix       = "porker_login"
+	loginActiveKeyPrefix = "porker_login_active"
+	feedTicketKeyPrefix  = "porker_feed_ticket"
+	loginTimeout         = time.Hour
+	// sessionIdleTimeout は session が使われていないとみなすまでの時間
+	sessionIdleTimeout = 5 * time.Minute
+	feedTicketTimeout  = 30 * time.Second
 )
 
 type (
@@ -42,30 +49,99 @@ func (r *loginRepository) FindByID(ctx context.Context, loginID string) (*porker
 }
 
 func (r *loginRepository) NewLogin(ctx context.Context, loginID string) (*porker.Login, error) {
-	sessionID := uuid.New()
+	sessionID := uuid.New().String()
 	if err := r.memDBCli.SetNX(ctx, loginKey(loginID), sessionID, loginTimeout); err != nil {
 		return nil, xerrors.Errorf("failed to SetNX: %w", err)
 	}
+	if err := r.memDBCli.Set(ctx, loginActiveKey(loginID), sessionID, sessionIdleTimeout); err != nil {
+		return nil, xerrors.Errorf("failed to Set: %w", err)
+	}
 	return &porker.Login{
 		LoginId:   loginID,
-		SessionId: sessionID.String(),
+		SessionId: sessionID,
 	}, nil
 }
 
-func (r *loginRepository) ReLogin(ctx context.Context, login *porker.Login) error {
-	if err := r.memDBCli.SetNX(ctx, loginKey(login.LoginId), login.SessionId, loginTimeout); err != nil {
-		return xerrors.Errorf("failed to SetNX: %w", err)
+// Renew replaces the session of the login with a new one, so the old session can no longer be used.
+func (r *loginRepository) Renew(ctx context.Context, loginID string) (*porker.Login, error) {
+	sessionID := uuid.New().String()
+	if err := r.memDBCli.Set(ctx, loginKey(loginID), sessionID, loginTimeout); err != nil {
+		return nil, xerrors.Errorf("failed to Set: %w", err)
+	}
+	if err := r.memDBCli.Set(ctx, loginActiveKey(loginID), sessionID, sessionIdleTimeout); err != nil {
+		return nil, xerrors.Errorf("failed to Set: %w", err)
+	}
+	return &porker.Login{
+		LoginId:   loginID,
+		SessionId: sessionID,
+	}, nil
+}
+
+// Touch marks the session as in use and extends the login.
+func (r *loginRepository) Touch(ctx context.Context, login *porker.Login) error {
+	if err := r.memDBCli.Expire(ctx, loginKey(login.LoginId), loginTimeout); err != nil {
+		return xerrors.Errorf("failed to Expire: %w", err)
+	}
+	if err := r.memDBCli.Set(ctx, loginActiveKey(login.LoginId), login.SessionId, sessionIdleTimeout); err != nil {
+		return xerrors.Errorf("failed to Set: %w", err)
 	}
 	return nil
 }
 
-func (r *loginRepository) Logout(ctx context.Context, loginID string) error {
-	err := r.memDBCli.Del(ctx, loginKey(loginID))
+// IsActive reports whether the session of the login has been used within sessionIdleTimeout.
+func (r *loginRepository) IsActive(ctx context.Context, loginID string) (bool, error) {
+	_, err := r.memDBCli.Get(ctx, loginActiveKey(loginID))
 	if errs.IsNotFoundError(err) {
-		return nil
+		return false, nil
+	}
+	if err != nil {
+		return false, xerrors.Errorf("failed to memdb get: %w", err)
+	}
+	return true, nil
+}
+
+// NewFeedTicket issues a ticket that stands for the login on the room feed of the room for a short time.
+func (r *loginRepository) NewFeedTicket(ctx context.Context, roomID room.ID, login *porker.Login) (string, error) {
+	b, err := proto.Marshal(login)
+	if err != nil {
+		return "", xerrors.Errorf("failed to proto.Marshal: %w", err)
+	}
+
+	ticket := uuid.New().String()
+	if err := r.memDBCli.Set(ctx, feedTicketKey(roomID, ticket), string(b), feedTicketTimeout); err != nil {
+		return "", xerrors.Errorf("failed to Set: %w", err)
 	}
+	return ticket, nil
+}
+
+// UseFeedTicket returns the login of the ticket and deletes the ticket.
+// It returns errs.NotFoundError if the ticket does not exist or has expired.
+func (r *loginRepository) UseFeedTicket(ctx context.Context, roomID room.ID, ticket string) (*porker.Login, error) {
+	key := feedTicketKey(roomID, ticket)
+	value, err := r.memDBCli.Get(ctx, key)
 	if err != nil {
-		return xerrors.Errorf("failed to Del: %w", err)
+		return nil, xerrors.Errorf("failed to memdb get: %w", err)
+	}
+	if err := r.memDBCli.Del(ctx, key); err != nil && !errs.IsNotFoundError(err) {
+		return nil, xerrors.Errorf("failed to Del: %w", err)
+	}
+
+	login := &porker.Login{}
+	if err := proto.Unmarshal([]byte(value), login); err != nil {
+		return nil, xerrors.Errorf("failed to proto.Unmarshal: %w", err)
+	}
+	return login, nil
+}
+
+func (r *loginRepository) Logout(ctx context.Context, loginID string) error {
+	for _, key := range []string{loginKey(loginID), loginActiveKey(loginID)} {
+		err := r.memDBCli.Del(ctx, key)
+		if errs.IsNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return xerrors.Errorf("failed to Del: %w", err)
+		}
 	}
 	return nil
 }
@@ -73,3 +149,11 @@ func (r *loginRepository) Logout(ctx context.Context, loginID string) error {
 func loginKey(loginID string) string {
 	return fmt.Sprintf("%s:%s", loginKeyPrefix, loginID)
 }
+
+func loginActiveKey(loginID string) string {
+	return fmt.Sprintf("%s:%s", loginActiveKeyPrefix, loginID)
+}
+
+func feedTicketKey(roomID room.ID, ticket string) string {
+	return fmt.Sprintf("%s:%s:%s", feedTicketKeyPrefix, roomID, ticket)
+}
@@ -2,15 +2,16 @@ package repositories
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/swallowarc/porker-proto/pkg/porker"
 	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/tracers"
 	"github.com/swallowarc/porker-rpc/internal/domains/room"
 	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
 	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -19,21 +20,30 @@ const (
 
 type (
 	PokerRepository struct {
-		memDBCli gateways.MemDBClient
+		memDBCli  gateways.MemDBClient
+		streamCli gateways.StreamClient
+		format    SituationFormat
+		keys      room.KeyStrategy
 	}
 )
 
-func NewPokerRepository(gwFactory gateways.Factory) ports.PokerRepository {
+func NewPokerRepository(gwFactory gateways.Factory, format SituationFormat, keys room.KeyStrategy) ports.PokerRepository {
 	return &PokerRepository{
-		memDBCli: gwFactory.MemDBClient(),
+		memDBCli:  gwFactory.MemDBClient(),
+		streamCli: gwFactory.StreamClient(),
+		format:    format,
+		keys:      keys,
 	}
 }
 
-func (r *PokerRepository) Create(ctx context.Context, loginID string) (room.ID, error) {
+func (r *PokerRepository) Create(ctx context.Context, loginID string, deck *porker.Deck) (room.ID, error) {
+	ctx, span := tracers.Start(ctx, "PokerRepository.Create", tracers.LoginID(loginID))
+	defer span.End()
+
 	var roomID room.ID
 	for {
 		roomID = room.NewID()
-		_, err := r.memDBCli.Get(ctx, roomID.IDKey())
+		_, err := r.memDBCli.Get(ctx, r.keys.IDKey(roomID))
 		if err == nil {
 			continue
 		}
@@ -43,15 +53,21 @@ func (r *PokerRepository) Create(ctx context.Context, loginID string) (room.ID,
 		return "", xerrors.Errorf("failed to memDBCli.Get: %w", err)
 	}
 
-	if err := r.memDBCli.SetNX(ctx, roomID.IDKey(), "", room.TimeoutDuration); err != nil {
+	if err := r.memDBCli.SetNX(ctx, r.keys.IDKey(roomID), "", room.TimeoutDuration); err != nil {
 		return "", xerrors.Errorf("failed to SetNX: %w", err)
 	}
+	// 期限切れで同じIDを使っていたRoomのstreamが残っていても履歴を引き継がない
+	if err := r.streamCli.Del(ctx, r.keys.StreamKey(roomID)); err != nil {
+		return "", xerrors.Errorf("failed to Del stream: %w", err)
+	}
 
 	situation := &porker.PokerSituation{
-		RoomId:        roomID.String(),
-		MasterLoginId: loginID,
-		State:         porker.RoomState_ROOM_STATE_TURN_DOWN,
-		Ballots:       []*porker.Ballot{},
+		RoomId:         roomID.String(),
+		MasterLoginId:  loginID,
+		State:          porker.RoomState_ROOM_STATE_TURN_DOWN,
+		Ballots:        []*porker.Ballot{},
+		Deck:           deck,
+		RoundStartedAt: timestamppb.Now(),
 	}
 	if err := r.Update(ctx, situation); err != nil { // UpdateでもStreamがなければ新規作成される
 		return "", err
@@ -61,28 +77,31 @@ func (r *PokerRepository) Create(ctx context.Context, loginID string) (room.ID,
 }
 
 func (r *PokerRepository) refreshRoomDuration(ctx context.Context, roomID room.ID) error {
-	if _, err := r.memDBCli.Get(ctx, roomID.IDKey()); err != nil {
-		return xerrors.Errorf("failed to Get room_id from memdb: %w", err)
+	ctx, span := tracers.Start(ctx, "PokerRepository.refreshRoomDuration", tracers.RoomID(roomID.String()))
+	defer span.End()
+
+	if err := r.exists(ctx, roomID); err != nil {
+		return err
 	}
 
 	eg := errgroup.Group{}
 
 	eg.Go(func() error {
-		if err := r.memDBCli.Expire(ctx, roomID.IDKey(), room.TimeoutDuration); err != nil {
+		if err := r.memDBCli.Expire(ctx, r.keys.IDKey(roomID), room.TimeoutDuration); err != nil {
 			return xerrors.Errorf("failed to Expire room: %w", err)
 		}
 		return nil
 	})
 
 	eg.Go(func() error {
-		if err := r.memDBCli.Expire(ctx, roomID.MemberKey(), room.TimeoutDuration); err != nil {
+		if err := r.memDBCli.Expire(ctx, r.keys.MemberKey(roomID), room.TimeoutDuration); err != nil {
 			return xerrors.Errorf("failed to Expire member: %w", err)
 		}
 		return nil
 	})
 
 	eg.Go(func() error {
-		if err := r.memDBCli.Expire(ctx, roomID.StreamKey(), room.TimeoutDuration); err != nil {
+		if err := r.streamCli.Expire(ctx, r.keys.StreamKey(roomID), room.TimeoutDuration); err != nil {
 			return xerrors.Errorf("failed to Expire stream: %w", err)
 		}
 		return nil
@@ -95,19 +114,31 @@ func (r *PokerRepository) refreshRoomDuration(ctx context.Context, roomID room.I
 	return nil
 }
 
+// exists returns errs.NotFoundError if the room has timed out.
+// The room stream may be left on stream transports that drop it later than the mem db, so the id key is the source of truth.
+func (r *PokerRepository) exists(ctx context.Context, roomID room.ID) error {
+	if _, err := r.memDBCli.Get(ctx, r.keys.IDKey(roomID)); err != nil {
+		return xerrors.Errorf("failed to Get room_id from memdb: %w", err)
+	}
+	return nil
+}
+
 func (r *PokerRepository) Update(ctx context.Context, ps *porker.PokerSituation) error {
+	ctx, span := tracers.Start(ctx, "PokerRepository.Update", tracers.RoomID(ps.RoomId))
+	defer span.End()
+
 	roomID := room.ID(ps.RoomId)
 	if err := r.refreshRoomDuration(ctx, roomID); err != nil {
 		return xerrors.Errorf("failed to refreshRoomDuration: %w", err)
 	}
 
-	jm, err := json.Marshal(ps)
+	payload, err := marshal(ps, r.format)
 	if err != nil {
-		return xerrors.Errorf("failed to json.Marshal: %w", err)
+		return xerrors.Errorf("failed to marshal: %w", err)
 	}
 
-	if err := r.memDBCli.PublishStream(ctx, roomID.StreamKey(), map[string]interface{}{
-		situationMessageKey: jm,
+	if err := r.streamCli.PublishStream(ctx, r.keys.StreamKey(roomID), map[string]interface{}{
+		situationMessageKey: payload,
 	}); err != nil {
 		return xerrors.Errorf("failed to PublishStream: %w", err)
 	}
@@ -115,12 +146,40 @@ func (r *PokerRepository) Update(ctx context.Context, ps *porker.PokerSituation)
 	return nil
 }
 
+// CompareAndUpdate publishes ps only if messageID is still the latest message of the room stream.
+// It returns errs.ConflictError when the room has been updated since messageID was read.
+func (r *PokerRepository) CompareAndUpdate(ctx context.Context, messageID string, ps *porker.PokerSituation) error {
+	ctx, span := tracers.Start(ctx, "PokerRepository.CompareAndUpdate", tracers.RoomID(ps.RoomId))
+	defer span.End()
+
+	roomID := room.ID(ps.RoomId)
+	if err := r.refreshRoomDuration(ctx, roomID); err != nil {
+		return xerrors.Errorf("failed to refreshRoomDuration: %w", err)
+	}
+
+	payload, err := marshal(ps, r.format)
+	if err != nil {
+		return xerrors.Errorf("failed to marshal: %w", err)
+	}
+
+	if err := r.streamCli.PublishStreamIfLatest(ctx, r.keys.StreamKey(roomID), messageID, map[string]interface{}{
+		situationMessageKey: payload,
+	}); err != nil {
+		return xerrors.Errorf("failed to PublishStreamIfLatest: %w", err)
+	}
+
+	return nil
+}
+
 func (r *PokerRepository) Enter(ctx context.Context, roomID room.ID, loginID string) error {
+	ctx, span := tracers.Start(ctx, "PokerRepository.Enter", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
 	if err := r.refreshRoomDuration(ctx, roomID); err != nil {
 		return xerrors.Errorf("failed to refreshRoomDuration: %w", err)
 	}
 
-	if err := r.memDBCli.SAdd(ctx, roomID.MemberKey(), loginID); err != nil {
+	if err := r.memDBCli.SAdd(ctx, r.keys.MemberKey(roomID), loginID); err != nil {
 		return xerrors.Errorf("failed to SAdd room member from memdb: %w", err)
 	}
 
@@ -128,11 +187,14 @@ func (r *PokerRepository) Enter(ctx context.Context, roomID room.ID, loginID str
 }
 
 func (r *PokerRepository) Leave(ctx context.Context, roomID room.ID, loginID string) error {
+	ctx, span := tracers.Start(ctx, "PokerRepository.Leave", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
 	if err := r.refreshRoomDuration(ctx, roomID); err != nil {
 		return xerrors.Errorf("failed to refreshRoomDuration: %w", err)
 	}
 
-	if err := r.memDBCli.SRem(ctx, roomID.MemberKey(), loginID); err != nil {
+	if err := r.memDBCli.SRem(ctx, r.keys.MemberKey(roomID), loginID); err != nil {
 		return xerrors.Errorf("failed to SRem from memdb: %w", err)
 	}
 
@@ -140,7 +202,14 @@ func (r *PokerRepository) Leave(ctx context.Context, roomID room.ID, loginID str
 }
 
 func (r *PokerRepository) ReadStreamLatest(ctx context.Context, roomID room.ID) (string, *porker.PokerSituation, error) {
-	msgID, msg, err := r.memDBCli.ReadStreamLatest(ctx, roomID.StreamKey(), situationMessageKey)
+	ctx, span := tracers.Start(ctx, "PokerRepository.ReadStreamLatest", tracers.RoomID(roomID.String()))
+	defer span.End()
+
+	if err := r.exists(ctx, roomID); err != nil {
+		return "", nil, err
+	}
+
+	msgID, msg, err := r.streamCli.ReadStreamLatest(ctx, r.keys.StreamKey(roomID), situationMessageKey)
 	if err != nil {
 		return "", nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
 	}
@@ -150,7 +219,10 @@ func (r *PokerRepository) ReadStreamLatest(ctx context.Context, roomID room.ID)
 }
 
 func (r *PokerRepository) ReadStream(ctx context.Context, roomID room.ID, messageID string) (string, *porker.PokerSituation, error) {
-	msgID, msg, err := r.memDBCli.ReadStream(ctx, roomID.StreamKey(), situationMessageKey, messageID)
+	ctx, span := tracers.Start(ctx, "PokerRepository.ReadStream", tracers.RoomID(roomID.String()))
+	defer span.End()
+
+	msgID, msg, err := r.streamCli.ReadStream(ctx, r.keys.StreamKey(roomID), situationMessageKey, messageID)
 	if err != nil {
 		return "", nil, xerrors.Errorf("failed to ReadStream: %w", err)
 	}
@@ -159,16 +231,40 @@ func (r *PokerRepository) ReadStream(ctx context.Context, roomID room.ID, messag
 	return msgID, result, err
 }
 
-func unmarshal(message string) (*porker.PokerSituation, error) {
-	var result porker.PokerSituation
-	if err := json.Unmarshal([]byte(message), &result); err != nil {
-		return nil, xerrors.Errorf("failed to json unmarshal. err: %w, msg: %s", err, message)
+func (r *PokerRepository) ListHistory(ctx context.Context, roomID room.ID) ([]*porker.RoomSnapshot, error) {
+	ctx, span := tracers.Start(ctx, "PokerRepository.ListHistory", tracers.RoomID(roomID.String()))
+	defer span.End()
+
+	if err := r.exists(ctx, roomID); err != nil {
+		return nil, err
+	}
+
+	msgs, err := r.streamCli.ReadStreamRange(ctx, r.keys.StreamKey(roomID), situationMessageKey)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ReadStreamRange: %w", err)
+	}
+
+	snapshots := make([]*porker.RoomSnapshot, 0, len(msgs))
+	for _, msg := range msgs {
+		ps, err := unmarshal(msg.Message)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, &porker.RoomSnapshot{
+			SnapshotId:  msg.ID,
+			PublishedAt: timestamppb.New(msg.PublishedAt),
+			Situation:   ps,
+		})
 	}
-	return &result, nil
+
+	return snapshots, nil
 }
 
 func (r *PokerRepository) ListMembers(ctx context.Context, roomID room.ID) ([]string, error) {
-	members, err := r.memDBCli.SMembers(ctx, roomID.MemberKey())
+	ctx, span := tracers.Start(ctx, "PokerRepository.ListMembers", tracers.RoomID(roomID.String()))
+	defer span.End()
+
+	members, err := r.memDBCli.SMembers(ctx, r.keys.MemberKey(roomID))
 	if err != nil {
 		return nil, xerrors.Errorf("failed to SMembers from memdb: %w", err)
 	}
@@ -192,19 +288,15 @@ func (r *PokerRepository) IsExistsInRoom(ctx context.Context, roomID room.ID, lo
 }
 
 func (r *PokerRepository) Delete(ctx context.Context, roomID room.ID) error {
-	eg := errgroup.Group{}
-	eg.Go(func() error {
-		return r.memDBCli.Del(ctx, roomID.IDKey())
-	})
-	eg.Go(func() error {
-		return r.memDBCli.Del(ctx, roomID.MemberKey())
-	})
-	eg.Go(func() error {
-		return r.memDBCli.Del(ctx, roomID.StreamKey())
-	})
-	if err := eg.Wait(); err != nil {
+	ctx, span := tracers.Start(ctx, "PokerRepository.Delete", tracers.RoomID(roomID.String()))
+	defer span.End()
+
+	if err := r.memDBCli.Del(ctx, r.keys.IDKey(roomID), r.keys.MemberKey(roomID)); err != nil {
 		return xerrors.Errorf("failed to Del from memdb: %w", err)
 	}
+	if err := r.streamCli.Del(ctx, r.keys.StreamKey(roomID)); err != nil {
+		return xerrors.Errorf("failed to Del stream: %w", err)
+	}
 
 	return nil
 }
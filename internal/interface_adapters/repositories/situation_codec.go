@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Stream payloads are stored in an envelope of the magic, one byte of schema version and the encoded situation.
+// Payloads without the magic are the legacy format, which is encoding/json of porker.PokerSituation.
+const (
+	envelopeMagic = "PKR"
+
+	// schemaVersionProto is a proto binary of porker.PokerSituation.
+	schemaVersionProto byte = 1
+)
+
+type (
+	// SituationFormat is the format of the situations written to room streams. Both formats are always read.
+	SituationFormat string
+)
+
+const (
+	// SituationFormatJSON is the legacy format, which instances without the envelope can also read.
+	// Keep it until every instance reads the envelope.
+	SituationFormatJSON SituationFormat = "json"
+	// SituationFormatProto is the versioned envelope of the proto binary.
+	SituationFormatProto SituationFormat = "proto"
+)
+
+func marshal(ps *porker.PokerSituation, format SituationFormat) ([]byte, error) {
+	if format != SituationFormatProto {
+		b, err := json.Marshal(ps)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to json.Marshal: %w", err)
+		}
+		return b, nil
+	}
+
+	payload, err := proto.Marshal(ps)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to proto.Marshal: %w", err)
+	}
+
+	b := make([]byte, 0, len(envelopeMagic)+1+len(payload))
+	b = append(b, envelopeMagic...)
+	b = append(b, schemaVersionProto)
+	return append(b, payload...), nil
+}
+
+func unmarshal(message string) (*porker.PokerSituation, error) {
+	if !strings.HasPrefix(message, envelopeMagic) || len(message) <= len(envelopeMagic) {
+		return unmarshalLegacy(message)
+	}
+
+	version, payload := message[len(envelopeMagic)], message[len(envelopeMagic)+1:]
+	switch version {
+	case schemaVersionProto:
+		var result porker.PokerSituation
+		if err := proto.Unmarshal([]byte(payload), &result); err != nil {
+			return nil, xerrors.Errorf("failed to proto unmarshal. err: %w", err)
+		}
+		return &result, nil
+	default:
+		return nil, xerrors.Errorf("unsupported schema version of stream payload: %d", version)
+	}
+}
+
+func unmarshalLegacy(message string) (*porker.PokerSituation, error) {
+	var result porker.PokerSituation
+	if err := json.Unmarshal([]byte(message), &result); err != nil {
+		return nil, xerrors.Errorf("failed to json unmarshal. err: %w, msg: %s", err, message)
+	}
+	return &result, nil
+}
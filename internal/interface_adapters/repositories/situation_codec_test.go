@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSituationCodec(t *testing.T) {
+	ps := &porker.PokerSituation{
+		RoomId:        "12345",
+		MasterLoginId: "master",
+		State:         porker.RoomState_ROOM_STATE_OPEN,
+		Ballots: []*porker.Ballot{
+			{LoginId: "master", Point: porker.Point_POINT_5},
+			{LoginId: "member", Point: porker.Point_NOT_VOTE},
+		},
+	}
+
+	for _, tc := range []struct {
+		format   SituationFormat
+		envelope bool
+	}{
+		{format: SituationFormatJSON, envelope: false},
+		{format: SituationFormatProto, envelope: true},
+	} {
+		t.Run(string(tc.format), func(t *testing.T) {
+			b, err := marshal(ps, tc.format)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+			if strings.HasPrefix(string(b), envelopeMagic) != tc.envelope {
+				t.Errorf("expected envelope %v, actual payload %q", tc.envelope, b)
+			}
+			actual, err := unmarshal(string(b))
+			if err != nil {
+				t.Fatalf("failed to unmarshal: %v", err)
+			}
+			if !proto.Equal(ps, actual) {
+				t.Errorf("expected %v, actual %v", ps, actual)
+			}
+		})
+	}
+}
+
+func TestSituationCodec_Legacy(t *testing.T) {
+	legacy := `{"room_id":"12345","master_login_id":"master","state":2,"ballots":[{"login_id":"master","point":6}]}`
+
+	actual, err := unmarshal(legacy)
+	if err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	if actual.State != porker.RoomState_ROOM_STATE_OPEN || actual.Ballots[0].Point != porker.Point_POINT_5 {
+		t.Errorf("unexpected situation: %v", actual)
+	}
+}
+
+func TestSituationCodec_UnsupportedVersion(t *testing.T) {
+	if _, err := unmarshal(envelopeMagic + "\xff"); err == nil {
+		t.Error("expected error by unsupported schema version")
+	}
+}
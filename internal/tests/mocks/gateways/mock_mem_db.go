@@ -10,6 +10,7 @@ import (
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	gateways "github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
 )
 
 // MockMemDBClient is a mock of MemDBClient interface.
@@ -35,18 +36,37 @@ func (m *MockMemDBClient) EXPECT() *MockMemDBClientMockRecorder {
 	return m.recorder
 }
 
+// Close mocks base method.
+func (m *MockMemDBClient) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockMemDBClientMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMemDBClient)(nil).Close))
+}
+
 // Del mocks base method.
-func (m *MockMemDBClient) Del(ctx context.Context, key string) error {
+func (m *MockMemDBClient) Del(ctx context.Context, keys ...string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Del", ctx, key)
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Del", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Del indicates an expected call of Del.
-func (mr *MockMemDBClientMockRecorder) Del(ctx, key interface{}) *gomock.Call {
+func (mr *MockMemDBClientMockRecorder) Del(ctx interface{}, keys ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockMemDBClient)(nil).Del), ctx, key)
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockMemDBClient)(nil).Del), varargs...)
 }
 
 // Expire mocks base method.
@@ -106,6 +126,20 @@ func (mr *MockMemDBClientMockRecorder) PublishStream(ctx, streamKey, messages in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStream", reflect.TypeOf((*MockMemDBClient)(nil).PublishStream), ctx, streamKey, messages)
 }
 
+// PublishStreamIfLatest mocks base method.
+func (m *MockMemDBClient) PublishStreamIfLatest(ctx context.Context, streamKey, latestID string, messages map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishStreamIfLatest", ctx, streamKey, latestID, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishStreamIfLatest indicates an expected call of PublishStreamIfLatest.
+func (mr *MockMemDBClientMockRecorder) PublishStreamIfLatest(ctx, streamKey, latestID, messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStreamIfLatest", reflect.TypeOf((*MockMemDBClient)(nil).PublishStreamIfLatest), ctx, streamKey, latestID, messages)
+}
+
 // ReadStream mocks base method.
 func (m *MockMemDBClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (string, string, error) {
 	m.ctrl.T.Helper()
@@ -138,6 +172,21 @@ func (mr *MockMemDBClientMockRecorder) ReadStreamLatest(ctx, streamKey, messageK
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStreamLatest", reflect.TypeOf((*MockMemDBClient)(nil).ReadStreamLatest), ctx, streamKey, messageKey)
 }
 
+// ReadStreamRange mocks base method.
+func (m *MockMemDBClient) ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]gateways.StreamMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStreamRange", ctx, streamKey, messageKey)
+	ret0, _ := ret[0].([]gateways.StreamMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStreamRange indicates an expected call of ReadStreamRange.
+func (mr *MockMemDBClientMockRecorder) ReadStreamRange(ctx, streamKey, messageKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStreamRange", reflect.TypeOf((*MockMemDBClient)(nil).ReadStreamRange), ctx, streamKey, messageKey)
+}
+
 // SAdd mocks base method.
 func (m *MockMemDBClient) SAdd(ctx context.Context, key string, values ...interface{}) error {
 	m.ctrl.T.Helper()
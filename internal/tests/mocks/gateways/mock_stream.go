@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stream.go
+
+// Package mock_gateways is a generated GoMock package.
+package mock_gateways
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	gateways "github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+)
+
+// MockStreamClient is a mock of StreamClient interface.
+type MockStreamClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamClientMockRecorder
+}
+
+// MockStreamClientMockRecorder is the mock recorder for MockStreamClient.
+type MockStreamClientMockRecorder struct {
+	mock *MockStreamClient
+}
+
+// NewMockStreamClient creates a new mock instance.
+func NewMockStreamClient(ctrl *gomock.Controller) *MockStreamClient {
+	mock := &MockStreamClient{ctrl: ctrl}
+	mock.recorder = &MockStreamClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamClient) EXPECT() *MockStreamClientMockRecorder {
+	return m.recorder
+}
+
+// Del mocks base method.
+func (m *MockStreamClient) Del(ctx context.Context, keys ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range keys {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Del", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Del indicates an expected call of Del.
+func (mr *MockStreamClientMockRecorder) Del(ctx interface{}, keys ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, keys...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockStreamClient)(nil).Del), varargs...)
+}
+
+// Expire mocks base method.
+func (m *MockStreamClient) Expire(ctx context.Context, key string, duration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expire", ctx, key, duration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expire indicates an expected call of Expire.
+func (mr *MockStreamClientMockRecorder) Expire(ctx, key, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expire", reflect.TypeOf((*MockStreamClient)(nil).Expire), ctx, key, duration)
+}
+
+// PublishStream mocks base method.
+func (m *MockStreamClient) PublishStream(ctx context.Context, streamKey string, messages map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishStream", ctx, streamKey, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishStream indicates an expected call of PublishStream.
+func (mr *MockStreamClientMockRecorder) PublishStream(ctx, streamKey, messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStream", reflect.TypeOf((*MockStreamClient)(nil).PublishStream), ctx, streamKey, messages)
+}
+
+// PublishStreamIfLatest mocks base method.
+func (m *MockStreamClient) PublishStreamIfLatest(ctx context.Context, streamKey, latestID string, messages map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishStreamIfLatest", ctx, streamKey, latestID, messages)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishStreamIfLatest indicates an expected call of PublishStreamIfLatest.
+func (mr *MockStreamClientMockRecorder) PublishStreamIfLatest(ctx, streamKey, latestID, messages interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishStreamIfLatest", reflect.TypeOf((*MockStreamClient)(nil).PublishStreamIfLatest), ctx, streamKey, latestID, messages)
+}
+
+// ReadStream mocks base method.
+func (m *MockStreamClient) ReadStream(ctx context.Context, streamKey, messageKey, previousID string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStream", ctx, streamKey, messageKey, previousID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadStream indicates an expected call of ReadStream.
+func (mr *MockStreamClientMockRecorder) ReadStream(ctx, streamKey, messageKey, previousID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStream", reflect.TypeOf((*MockStreamClient)(nil).ReadStream), ctx, streamKey, messageKey, previousID)
+}
+
+// ReadStreamLatest mocks base method.
+func (m *MockStreamClient) ReadStreamLatest(ctx context.Context, streamKey, messageKey string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStreamLatest", ctx, streamKey, messageKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadStreamLatest indicates an expected call of ReadStreamLatest.
+func (mr *MockStreamClientMockRecorder) ReadStreamLatest(ctx, streamKey, messageKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStreamLatest", reflect.TypeOf((*MockStreamClient)(nil).ReadStreamLatest), ctx, streamKey, messageKey)
+}
+
+// ReadStreamRange mocks base method.
+func (m *MockStreamClient) ReadStreamRange(ctx context.Context, streamKey, messageKey string) ([]gateways.StreamMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadStreamRange", ctx, streamKey, messageKey)
+	ret0, _ := ret[0].([]gateways.StreamMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadStreamRange indicates an expected call of ReadStreamRange.
+func (mr *MockStreamClientMockRecorder) ReadStreamRange(ctx, streamKey, messageKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadStreamRange", reflect.TypeOf((*MockStreamClient)(nil).ReadStreamRange), ctx, streamKey, messageKey)
+}
@@ -7,6 +7,7 @@ package mock_interactors
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	porker "github.com/swallowarc/porker-proto/pkg/porker"
@@ -37,6 +38,35 @@ func (m *MockLoginInteractor) EXPECT() *MockLoginInteractorMockRecorder {
 	return m.recorder
 }
 
+// Authenticate mocks base method.
+func (m *MockLoginInteractor) Authenticate(ctx context.Context, login *porker.Login) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockLoginInteractorMockRecorder) Authenticate(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockLoginInteractor)(nil).Authenticate), ctx, login)
+}
+
+// IssueFeedTicket mocks base method.
+func (m *MockLoginInteractor) IssueFeedTicket(ctx context.Context, roomID room.ID, login *porker.Login) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueFeedTicket", ctx, roomID, login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueFeedTicket indicates an expected call of IssueFeedTicket.
+func (mr *MockLoginInteractorMockRecorder) IssueFeedTicket(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueFeedTicket", reflect.TypeOf((*MockLoginInteractor)(nil).IssueFeedTicket), ctx, roomID, login)
+}
+
 // Login mocks base method.
 func (m *MockLoginInteractor) Login(ctx context.Context, login *porker.Login) (*porker.Login, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +96,21 @@ func (mr *MockLoginInteractorMockRecorder) Logout(ctx, login interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockLoginInteractor)(nil).Logout), ctx, login)
 }
 
+// UseFeedTicket mocks base method.
+func (m *MockLoginInteractor) UseFeedTicket(ctx context.Context, roomID room.ID, ticket string) (*porker.Login, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseFeedTicket", ctx, roomID, ticket)
+	ret0, _ := ret[0].(*porker.Login)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseFeedTicket indicates an expected call of UseFeedTicket.
+func (mr *MockLoginInteractorMockRecorder) UseFeedTicket(ctx, roomID, ticket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseFeedTicket", reflect.TypeOf((*MockLoginInteractor)(nil).UseFeedTicket), ctx, roomID, ticket)
+}
+
 // MockPokerInteractor is a mock of PokerInteractor interface.
 type MockPokerInteractor struct {
 	ctrl     *gomock.Controller
@@ -89,6 +134,36 @@ func (m *MockPokerInteractor) EXPECT() *MockPokerInteractorMockRecorder {
 	return m.recorder
 }
 
+// AddStory mocks base method.
+func (m *MockPokerInteractor) AddStory(ctx context.Context, roomID room.ID, loginID string, s *porker.Story) (*porker.Story, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddStory", ctx, roomID, loginID, s)
+	ret0, _ := ret[0].(*porker.Story)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddStory indicates an expected call of AddStory.
+func (mr *MockPokerInteractorMockRecorder) AddStory(ctx, roomID, loginID, s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStory", reflect.TypeOf((*MockPokerInteractor)(nil).AddStory), ctx, roomID, loginID, s)
+}
+
+// ArchivedRounds mocks base method.
+func (m *MockPokerInteractor) ArchivedRounds(ctx context.Context, roomID room.ID, loginID string) ([]*porker.ArchivedRound, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivedRounds", ctx, roomID, loginID)
+	ret0, _ := ret[0].([]*porker.ArchivedRound)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchivedRounds indicates an expected call of ArchivedRounds.
+func (mr *MockPokerInteractorMockRecorder) ArchivedRounds(ctx, roomID, loginID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivedRounds", reflect.TypeOf((*MockPokerInteractor)(nil).ArchivedRounds), ctx, roomID, loginID)
+}
+
 // CanEnter mocks base method.
 func (m *MockPokerInteractor) CanEnter(ctx context.Context, roomID room.ID) (bool, error) {
 	m.ctrl.T.Helper()
@@ -104,34 +179,75 @@ func (mr *MockPokerInteractorMockRecorder) CanEnter(ctx, roomID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanEnter", reflect.TypeOf((*MockPokerInteractor)(nil).CanEnter), ctx, roomID)
 }
 
+// ChangeRole mocks base method.
+func (m *MockPokerInteractor) ChangeRole(ctx context.Context, roomID room.ID, loginID, memberLoginID string, role porker.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeRole", ctx, roomID, loginID, memberLoginID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeRole indicates an expected call of ChangeRole.
+func (mr *MockPokerInteractorMockRecorder) ChangeRole(ctx, roomID, loginID, memberLoginID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeRole", reflect.TypeOf((*MockPokerInteractor)(nil).ChangeRole), ctx, roomID, loginID, memberLoginID, role)
+}
+
+// Close mocks base method.
+func (m *MockPokerInteractor) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockPokerInteractorMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPokerInteractor)(nil).Close))
+}
+
 // Create mocks base method.
-func (m *MockPokerInteractor) Create(ctx context.Context, loginID string) (room.ID, error) {
+func (m *MockPokerInteractor) Create(ctx context.Context, loginID string, deck *porker.Deck) (room.ID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, loginID)
+	ret := m.ctrl.Call(m, "Create", ctx, loginID, deck)
 	ret0, _ := ret[0].(room.ID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockPokerInteractorMockRecorder) Create(ctx, loginID interface{}) *gomock.Call {
+func (mr *MockPokerInteractorMockRecorder) Create(ctx, loginID, deck interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPokerInteractor)(nil).Create), ctx, loginID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPokerInteractor)(nil).Create), ctx, loginID, deck)
 }
 
 // Enter mocks base method.
-func (m *MockPokerInteractor) Enter(ctx context.Context, roomID room.ID, loginID string) (ports.PokerListener, error) {
+func (m *MockPokerInteractor) Enter(ctx context.Context, roomID room.ID, loginID string, role porker.Role) (ports.PokerListener, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Enter", ctx, roomID, loginID)
+	ret := m.ctrl.Call(m, "Enter", ctx, roomID, loginID, role)
 	ret0, _ := ret[0].(ports.PokerListener)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Enter indicates an expected call of Enter.
-func (mr *MockPokerInteractorMockRecorder) Enter(ctx, roomID, loginID interface{}) *gomock.Call {
+func (mr *MockPokerInteractorMockRecorder) Enter(ctx, roomID, loginID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enter", reflect.TypeOf((*MockPokerInteractor)(nil).Enter), ctx, roomID, loginID, role)
+}
+
+// History mocks base method.
+func (m *MockPokerInteractor) History(ctx context.Context, roomID room.ID, loginID string) ([]*porker.RoomSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, roomID, loginID)
+	ret0, _ := ret[0].([]*porker.RoomSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockPokerInteractorMockRecorder) History(ctx, roomID, loginID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enter", reflect.TypeOf((*MockPokerInteractor)(nil).Enter), ctx, roomID, loginID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockPokerInteractor)(nil).History), ctx, roomID, loginID)
 }
 
 // Leave mocks base method.
@@ -148,18 +264,60 @@ func (mr *MockPokerInteractorMockRecorder) Leave(ctx, roomID, loginID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockPokerInteractor)(nil).Leave), ctx, roomID, loginID)
 }
 
+// MoveStory mocks base method.
+func (m *MockPokerInteractor) MoveStory(ctx context.Context, roomID room.ID, loginID, storyID string, index int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveStory", ctx, roomID, loginID, storyID, index)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveStory indicates an expected call of MoveStory.
+func (mr *MockPokerInteractorMockRecorder) MoveStory(ctx, roomID, loginID, storyID, index interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveStory", reflect.TypeOf((*MockPokerInteractor)(nil).MoveStory), ctx, roomID, loginID, storyID, index)
+}
+
+// RemoveStory mocks base method.
+func (m *MockPokerInteractor) RemoveStory(ctx context.Context, roomID room.ID, loginID, storyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveStory", ctx, roomID, loginID, storyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveStory indicates an expected call of RemoveStory.
+func (mr *MockPokerInteractorMockRecorder) RemoveStory(ctx, roomID, loginID, storyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveStory", reflect.TypeOf((*MockPokerInteractor)(nil).RemoveStory), ctx, roomID, loginID, storyID)
+}
+
 // Reset mocks base method.
-func (m *MockPokerInteractor) Reset(ctx context.Context, roomID room.ID) error {
+func (m *MockPokerInteractor) Reset(ctx context.Context, roomID room.ID, loginID, estimate string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Reset", ctx, roomID)
+	ret := m.ctrl.Call(m, "Reset", ctx, roomID, loginID, estimate)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Reset indicates an expected call of Reset.
-func (mr *MockPokerInteractorMockRecorder) Reset(ctx, roomID interface{}) *gomock.Call {
+func (mr *MockPokerInteractorMockRecorder) Reset(ctx, roomID, loginID, estimate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockPokerInteractor)(nil).Reset), ctx, roomID, loginID, estimate)
+}
+
+// StartTimer mocks base method.
+func (m *MockPokerInteractor) StartTimer(ctx context.Context, roomID room.ID, loginID string, duration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", ctx, roomID, loginID, duration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockPokerInteractorMockRecorder) StartTimer(ctx, roomID, loginID, duration interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockPokerInteractor)(nil).Reset), ctx, roomID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockPokerInteractor)(nil).StartTimer), ctx, roomID, loginID, duration)
 }
 
 // VoteCounting mocks base method.
@@ -177,15 +335,30 @@ func (mr *MockPokerInteractorMockRecorder) VoteCounting(ctx, roomID, loginID int
 }
 
 // Voting mocks base method.
-func (m *MockPokerInteractor) Voting(ctx context.Context, roomID room.ID, loginID string, point porker.Point) error {
+func (m *MockPokerInteractor) Voting(ctx context.Context, roomID room.ID, loginID string, point porker.Point, card string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Voting", ctx, roomID, loginID, point)
+	ret := m.ctrl.Call(m, "Voting", ctx, roomID, loginID, point, card)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Voting indicates an expected call of Voting.
-func (mr *MockPokerInteractorMockRecorder) Voting(ctx, roomID, loginID, point interface{}) *gomock.Call {
+func (mr *MockPokerInteractorMockRecorder) Voting(ctx, roomID, loginID, point, card interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Voting", reflect.TypeOf((*MockPokerInteractor)(nil).Voting), ctx, roomID, loginID, point, card)
+}
+
+// Watch mocks base method.
+func (m *MockPokerInteractor) Watch(ctx context.Context, roomID room.ID) (ports.PokerListener, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, roomID)
+	ret0, _ := ret[0].(ports.PokerListener)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockPokerInteractorMockRecorder) Watch(ctx, roomID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Voting", reflect.TypeOf((*MockPokerInteractor)(nil).Voting), ctx, roomID, loginID, point)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockPokerInteractor)(nil).Watch), ctx, roomID)
 }
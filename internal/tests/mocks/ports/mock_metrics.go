@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: metrics.go
+
+// Package mock_ports is a generated GoMock package.
+package mock_ports
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPokerMetrics is a mock of PokerMetrics interface.
+type MockPokerMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockPokerMetricsMockRecorder
+}
+
+// MockPokerMetricsMockRecorder is the mock recorder for MockPokerMetrics.
+type MockPokerMetricsMockRecorder struct {
+	mock *MockPokerMetrics
+}
+
+// NewMockPokerMetrics creates a new mock instance.
+func NewMockPokerMetrics(ctrl *gomock.Controller) *MockPokerMetrics {
+	mock := &MockPokerMetrics{ctrl: ctrl}
+	mock.recorder = &MockPokerMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPokerMetrics) EXPECT() *MockPokerMetricsMockRecorder {
+	return m.recorder
+}
+
+// ListenerAdded mocks base method.
+func (m *MockPokerMetrics) ListenerAdded() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListenerAdded")
+}
+
+// ListenerAdded indicates an expected call of ListenerAdded.
+func (mr *MockPokerMetricsMockRecorder) ListenerAdded() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerAdded", reflect.TypeOf((*MockPokerMetrics)(nil).ListenerAdded))
+}
+
+// ListenerRemoved mocks base method.
+func (m *MockPokerMetrics) ListenerRemoved() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListenerRemoved")
+}
+
+// ListenerRemoved indicates an expected call of ListenerRemoved.
+func (mr *MockPokerMetricsMockRecorder) ListenerRemoved() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRemoved", reflect.TypeOf((*MockPokerMetrics)(nil).ListenerRemoved))
+}
+
+// Reset mocks base method.
+func (m *MockPokerMetrics) Reset() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Reset")
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockPokerMetricsMockRecorder) Reset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockPokerMetrics)(nil).Reset))
+}
+
+// Revealed mocks base method.
+func (m *MockPokerMetrics) Revealed() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Revealed")
+}
+
+// Revealed indicates an expected call of Revealed.
+func (mr *MockPokerMetricsMockRecorder) Revealed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revealed", reflect.TypeOf((*MockPokerMetrics)(nil).Revealed))
+}
+
+// RoomActivated mocks base method.
+func (m *MockPokerMetrics) RoomActivated() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RoomActivated")
+}
+
+// RoomActivated indicates an expected call of RoomActivated.
+func (mr *MockPokerMetricsMockRecorder) RoomActivated() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoomActivated", reflect.TypeOf((*MockPokerMetrics)(nil).RoomActivated))
+}
+
+// RoomDeactivated mocks base method.
+func (m *MockPokerMetrics) RoomDeactivated() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RoomDeactivated")
+}
+
+// RoomDeactivated indicates an expected call of RoomDeactivated.
+func (mr *MockPokerMetricsMockRecorder) RoomDeactivated() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoomDeactivated", reflect.TypeOf((*MockPokerMetrics)(nil).RoomDeactivated))
+}
+
+// Voted mocks base method.
+func (m *MockPokerMetrics) Voted() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Voted")
+}
+
+// Voted indicates an expected call of Voted.
+func (mr *MockPokerMetricsMockRecorder) Voted() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Voted", reflect.TypeOf((*MockPokerMetrics)(nil).Voted))
+}
+
+// WatcherAdded mocks base method.
+func (m *MockPokerMetrics) WatcherAdded() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WatcherAdded")
+}
+
+// WatcherAdded indicates an expected call of WatcherAdded.
+func (mr *MockPokerMetricsMockRecorder) WatcherAdded() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatcherAdded", reflect.TypeOf((*MockPokerMetrics)(nil).WatcherAdded))
+}
+
+// WatcherRemoved mocks base method.
+func (m *MockPokerMetrics) WatcherRemoved() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WatcherRemoved")
+}
+
+// WatcherRemoved indicates an expected call of WatcherRemoved.
+func (mr *MockPokerMetricsMockRecorder) WatcherRemoved() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatcherRemoved", reflect.TypeOf((*MockPokerMetrics)(nil).WatcherRemoved))
+}
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	porker "github.com/swallowarc/porker-proto/pkg/porker"
 	room "github.com/swallowarc/porker-rpc/internal/domains/room"
+	round "github.com/swallowarc/porker-rpc/internal/domains/round"
 )
 
 // MockLoginRepository is a mock of LoginRepository interface.
@@ -51,6 +52,21 @@ func (mr *MockLoginRepositoryMockRecorder) FindByID(ctx, loginID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockLoginRepository)(nil).FindByID), ctx, loginID)
 }
 
+// IsActive mocks base method.
+func (m *MockLoginRepository) IsActive(ctx context.Context, loginID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActive", ctx, loginID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActive indicates an expected call of IsActive.
+func (mr *MockLoginRepositoryMockRecorder) IsActive(ctx, loginID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActive", reflect.TypeOf((*MockLoginRepository)(nil).IsActive), ctx, loginID)
+}
+
 // Logout mocks base method.
 func (m *MockLoginRepository) Logout(ctx context.Context, loginID string) error {
 	m.ctrl.T.Helper()
@@ -65,6 +81,21 @@ func (mr *MockLoginRepositoryMockRecorder) Logout(ctx, loginID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockLoginRepository)(nil).Logout), ctx, loginID)
 }
 
+// NewFeedTicket mocks base method.
+func (m *MockLoginRepository) NewFeedTicket(ctx context.Context, roomID room.ID, login *porker.Login) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewFeedTicket", ctx, roomID, login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewFeedTicket indicates an expected call of NewFeedTicket.
+func (mr *MockLoginRepositoryMockRecorder) NewFeedTicket(ctx, roomID, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFeedTicket", reflect.TypeOf((*MockLoginRepository)(nil).NewFeedTicket), ctx, roomID, login)
+}
+
 // NewLogin mocks base method.
 func (m *MockLoginRepository) NewLogin(ctx context.Context, loginID string) (*porker.Login, error) {
 	m.ctrl.T.Helper()
@@ -80,18 +111,48 @@ func (mr *MockLoginRepositoryMockRecorder) NewLogin(ctx, loginID interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewLogin", reflect.TypeOf((*MockLoginRepository)(nil).NewLogin), ctx, loginID)
 }
 
-// ReLogin mocks base method.
-func (m *MockLoginRepository) ReLogin(ctx context.Context, login *porker.Login) error {
+// Renew mocks base method.
+func (m *MockLoginRepository) Renew(ctx context.Context, loginID string) (*porker.Login, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Renew", ctx, loginID)
+	ret0, _ := ret[0].(*porker.Login)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Renew indicates an expected call of Renew.
+func (mr *MockLoginRepositoryMockRecorder) Renew(ctx, loginID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Renew", reflect.TypeOf((*MockLoginRepository)(nil).Renew), ctx, loginID)
+}
+
+// Touch mocks base method.
+func (m *MockLoginRepository) Touch(ctx context.Context, login *porker.Login) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReLogin", ctx, login)
+	ret := m.ctrl.Call(m, "Touch", ctx, login)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ReLogin indicates an expected call of ReLogin.
-func (mr *MockLoginRepositoryMockRecorder) ReLogin(ctx, login interface{}) *gomock.Call {
+// Touch indicates an expected call of Touch.
+func (mr *MockLoginRepositoryMockRecorder) Touch(ctx, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockLoginRepository)(nil).Touch), ctx, login)
+}
+
+// UseFeedTicket mocks base method.
+func (m *MockLoginRepository) UseFeedTicket(ctx context.Context, roomID room.ID, ticket string) (*porker.Login, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseFeedTicket", ctx, roomID, ticket)
+	ret0, _ := ret[0].(*porker.Login)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseFeedTicket indicates an expected call of UseFeedTicket.
+func (mr *MockLoginRepositoryMockRecorder) UseFeedTicket(ctx, roomID, ticket interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReLogin", reflect.TypeOf((*MockLoginRepository)(nil).ReLogin), ctx, login)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseFeedTicket", reflect.TypeOf((*MockLoginRepository)(nil).UseFeedTicket), ctx, roomID, ticket)
 }
 
 // MockPokerRepository is a mock of PokerRepository interface.
@@ -117,19 +178,33 @@ func (m *MockPokerRepository) EXPECT() *MockPokerRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CompareAndUpdate mocks base method.
+func (m *MockPokerRepository) CompareAndUpdate(ctx context.Context, messageID string, ps *porker.PokerSituation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareAndUpdate", ctx, messageID, ps)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompareAndUpdate indicates an expected call of CompareAndUpdate.
+func (mr *MockPokerRepositoryMockRecorder) CompareAndUpdate(ctx, messageID, ps interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndUpdate", reflect.TypeOf((*MockPokerRepository)(nil).CompareAndUpdate), ctx, messageID, ps)
+}
+
 // Create mocks base method.
-func (m *MockPokerRepository) Create(ctx context.Context, loginID string) (room.ID, error) {
+func (m *MockPokerRepository) Create(ctx context.Context, loginID string, deck *porker.Deck) (room.ID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, loginID)
+	ret := m.ctrl.Call(m, "Create", ctx, loginID, deck)
 	ret0, _ := ret[0].(room.ID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockPokerRepositoryMockRecorder) Create(ctx, loginID interface{}) *gomock.Call {
+func (mr *MockPokerRepositoryMockRecorder) Create(ctx, loginID, deck interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPokerRepository)(nil).Create), ctx, loginID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPokerRepository)(nil).Create), ctx, loginID, deck)
 }
 
 // Delete mocks base method.
@@ -189,6 +264,21 @@ func (mr *MockPokerRepositoryMockRecorder) Leave(ctx, roomID, loginID interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Leave", reflect.TypeOf((*MockPokerRepository)(nil).Leave), ctx, roomID, loginID)
 }
 
+// ListHistory mocks base method.
+func (m *MockPokerRepository) ListHistory(ctx context.Context, roomID room.ID) ([]*porker.RoomSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistory", ctx, roomID)
+	ret0, _ := ret[0].([]*porker.RoomSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistory indicates an expected call of ListHistory.
+func (mr *MockPokerRepositoryMockRecorder) ListHistory(ctx, roomID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistory", reflect.TypeOf((*MockPokerRepository)(nil).ListHistory), ctx, roomID)
+}
+
 // ListMembers mocks base method.
 func (m *MockPokerRepository) ListMembers(ctx context.Context, roomID room.ID) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -249,3 +339,55 @@ func (mr *MockPokerRepositoryMockRecorder) Update(ctx, ps interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPokerRepository)(nil).Update), ctx, ps)
 }
+
+// MockArchiveRepository is a mock of ArchiveRepository interface.
+type MockArchiveRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockArchiveRepositoryMockRecorder
+}
+
+// MockArchiveRepositoryMockRecorder is the mock recorder for MockArchiveRepository.
+type MockArchiveRepositoryMockRecorder struct {
+	mock *MockArchiveRepository
+}
+
+// NewMockArchiveRepository creates a new mock instance.
+func NewMockArchiveRepository(ctrl *gomock.Controller) *MockArchiveRepository {
+	mock := &MockArchiveRepository{ctrl: ctrl}
+	mock.recorder = &MockArchiveRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArchiveRepository) EXPECT() *MockArchiveRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByRoom mocks base method.
+func (m *MockArchiveRepository) ListByRoom(ctx context.Context, roomID room.ID) ([]*round.Round, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByRoom", ctx, roomID)
+	ret0, _ := ret[0].([]*round.Round)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByRoom indicates an expected call of ListByRoom.
+func (mr *MockArchiveRepositoryMockRecorder) ListByRoom(ctx, roomID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRoom", reflect.TypeOf((*MockArchiveRepository)(nil).ListByRoom), ctx, roomID)
+}
+
+// Save mocks base method.
+func (m *MockArchiveRepository) Save(ctx context.Context, rd *round.Round) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, rd)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockArchiveRepositoryMockRecorder) Save(ctx, rd interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockArchiveRepository)(nil).Save), ctx, rd)
+}
@@ -0,0 +1,135 @@
+package interactors
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+)
+
+type (
+	// expireFunc is called when the deadline of the room passes.
+	expireFunc func(ctx context.Context, roomID room.ID, deadline time.Time)
+
+	// clock runs f after d. It is replaced in tests to expire deadlines without waiting for them.
+	clock interface {
+		AfterFunc(d time.Duration, f func()) stopper
+	}
+
+	stopper interface {
+		Stop() bool
+	}
+
+	realClock struct{}
+
+	// deadlineTimer calls expire when the voting deadline of a room passes.
+	// Every instance listening to the room schedules the deadline, so the room is revealed even if
+	// the instance that started the timer has gone. expire must be safe to be called by several instances.
+	deadlineTimer struct {
+		expire expireFunc
+		clock  clock
+
+		// ctx is canceled by stop, so that running expire calls give up
+		ctx       context.Context
+		cancelCtx context.CancelFunc
+		wg        sync.WaitGroup
+
+		mu      sync.Mutex
+		timers  map[room.ID]*scheduledDeadline
+		stopped bool
+	}
+
+	scheduledDeadline struct {
+		deadline time.Time
+		timer    stopper
+	}
+)
+
+func (realClock) AfterFunc(d time.Duration, f func()) stopper {
+	return time.AfterFunc(d, f)
+}
+
+func newDeadlineTimer(expire expireFunc, c clock) *deadlineTimer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &deadlineTimer{
+		expire:    expire,
+		clock:     c,
+		ctx:       ctx,
+		cancelCtx: cancel,
+		timers:    map[room.ID]*scheduledDeadline{},
+	}
+}
+
+// observe schedules the deadline of the situation, or cancels the scheduled one if the situation does not have it.
+func (t *deadlineTimer) observe(ctx context.Context, ps *porker.PokerSituation) {
+	roomID := room.ID(ps.RoomId)
+	if ps.Deadline == nil || ps.State != porker.RoomState_ROOM_STATE_TURN_DOWN {
+		t.cancel(roomID)
+		return
+	}
+	t.schedule(ctx, roomID, ps.Deadline.AsTime())
+}
+
+// schedule replaces the scheduled deadline of the room. A deadline that has already passed expires immediately.
+// It does nothing after stop.
+func (t *deadlineTimer) schedule(ctx context.Context, roomID room.ID, deadline time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stopped {
+		return
+	}
+	if s, ok := t.timers[roomID]; ok {
+		if s.deadline.Equal(deadline) {
+			return
+		}
+		s.timer.Stop()
+	}
+
+	// ctx of the request or the room feed ends before the deadline, so only its logger is taken over.
+	expireCtx := loggers.LoggerToContext(t.ctx, loggers.Logger(ctx))
+	s := &scheduledDeadline{deadline: deadline}
+	s.timer = t.clock.AfterFunc(time.Until(deadline), func() {
+		t.mu.Lock()
+		if t.stopped || t.timers[roomID] != s {
+			// stopped, or replaced after the timer had fired
+			t.mu.Unlock()
+			return
+		}
+		delete(t.timers, roomID)
+		t.wg.Add(1)
+		t.mu.Unlock()
+
+		defer t.wg.Done()
+		t.expire(expireCtx, roomID, deadline)
+	})
+	t.timers[roomID] = s
+}
+
+func (t *deadlineTimer) cancel(roomID room.ID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if s, ok := t.timers[roomID]; ok {
+		s.timer.Stop()
+		delete(t.timers, roomID)
+	}
+}
+
+// stop cancels the scheduled deadlines and waits for the running expire calls,
+// so that the repositories can be closed after it.
+func (t *deadlineTimer) stop() {
+	t.mu.Lock()
+	t.stopped = true
+	for roomID, s := range t.timers {
+		s.timer.Stop()
+		delete(t.timers, roomID)
+	}
+	t.mu.Unlock()
+
+	t.cancelCtx()
+	t.wg.Wait()
+}
@@ -0,0 +1,201 @@
+package interactors
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	expiration struct {
+		roomID   room.ID
+		deadline time.Time
+	}
+
+	// fakeClock runs the scheduled functions only when the test fires them.
+	fakeClock struct {
+		scheduled chan *fakeTimer
+	}
+
+	fakeTimer struct {
+		f       func()
+		stopped atomic.Bool
+	}
+)
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{scheduled: make(chan *fakeTimer, 10)}
+}
+
+func (c *fakeClock) AfterFunc(_ time.Duration, f func()) stopper {
+	ft := &fakeTimer{f: f}
+	c.scheduled <- ft
+	return ft
+}
+
+// next returns the function scheduled next, waiting for the listener of the room to schedule it.
+func (c *fakeClock) next(t *testing.T) *fakeTimer {
+	t.Helper()
+	select {
+	case ft := <-c.scheduled:
+		return ft
+	case <-time.After(5 * time.Second):
+		t.Fatal("nothing was scheduled")
+		return nil
+	}
+}
+
+func (ft *fakeTimer) Stop() bool {
+	return !ft.stopped.Swap(true)
+}
+
+// fire runs the function in the goroutine of the caller unless it has been stopped.
+func (ft *fakeTimer) fire() {
+	if !ft.stopped.Swap(true) {
+		ft.f()
+	}
+}
+
+func newTestTimer() (*deadlineTimer, <-chan expiration) {
+	ch := make(chan expiration, 10)
+	return newDeadlineTimer(func(_ context.Context, roomID room.ID, deadline time.Time) {
+		ch <- expiration{roomID: roomID, deadline: deadline}
+	}, realClock{}), ch
+}
+
+func situation(deadline time.Time) *porker.PokerSituation {
+	return &porker.PokerSituation{
+		RoomId:   "12345",
+		State:    porker.RoomState_ROOM_STATE_TURN_DOWN,
+		Deadline: timestamppb.New(deadline),
+	}
+}
+
+func TestDeadlineTimer_Observe(t *testing.T) {
+	ctx := context.Background()
+	timer, expired := newTestTimer()
+
+	deadline := time.Now().Add(50 * time.Millisecond)
+	// the same deadline is read by the feed many times
+	for i := 0; i < 3; i++ {
+		timer.observe(ctx, situation(deadline))
+	}
+
+	select {
+	case e := <-expired:
+		if e.roomID != "12345" || !e.deadline.Equal(deadline) {
+			t.Errorf("unexpected expiration: %v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("deadline did not expire")
+	}
+	select {
+	case e := <-expired:
+		t.Errorf("deadline must expire once: %v", e)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestDeadlineTimer_Replace(t *testing.T) {
+	ctx := context.Background()
+	timer, expired := newTestTimer()
+
+	timer.observe(ctx, situation(time.Now().Add(50*time.Millisecond)))
+	// restarted timer
+	deadline := time.Now().Add(100 * time.Millisecond)
+	timer.observe(ctx, situation(deadline))
+
+	select {
+	case e := <-expired:
+		if !e.deadline.Equal(deadline) {
+			t.Errorf("replaced deadline expired: %v", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("deadline did not expire")
+	}
+}
+
+func TestDeadlineTimer_Cancel(t *testing.T) {
+	ctx := context.Background()
+	timer, expired := newTestTimer()
+
+	timer.observe(ctx, situation(time.Now().Add(50*time.Millisecond)))
+	// revealed by votes before the deadline
+	timer.observe(ctx, &porker.PokerSituation{RoomId: "12345", State: porker.RoomState_ROOM_STATE_OPEN})
+
+	select {
+	case e := <-expired:
+		t.Errorf("canceled deadline expired: %v", e)
+	case <-time.After(150 * time.Millisecond):
+	}
+}
+
+func TestDeadlineTimer_Passed(t *testing.T) {
+	timer, expired := newTestTimer()
+
+	// a room read after its deadline expires immediately
+	timer.observe(context.Background(), situation(time.Now().Add(-time.Minute)))
+
+	select {
+	case <-expired:
+	case <-time.After(time.Second):
+		t.Fatal("passed deadline did not expire")
+	}
+}
+
+func TestDeadlineTimer_Stop(t *testing.T) {
+	ctx := context.Background()
+	clock := newFakeClock()
+	started := make(chan context.Context)
+	release := make(chan struct{})
+	timer := newDeadlineTimer(func(ctx context.Context, _ room.ID, _ time.Time) {
+		started <- ctx
+		<-release
+	}, clock)
+
+	timer.observe(ctx, situation(time.Now().Add(time.Minute)))
+	go clock.next(t).fire()
+	expireCtx := <-started
+
+	// another room is canceled by stop before its deadline
+	other := situation(time.Now().Add(time.Minute))
+	other.RoomId = "67890"
+	timer.observe(ctx, other)
+	pending := clock.next(t)
+
+	stopped := make(chan struct{})
+	go func() {
+		timer.stop()
+		close(stopped)
+	}()
+
+	// stop cancels the running expire and waits for it
+	select {
+	case <-expireCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expire must be canceled by stop")
+	}
+	select {
+	case <-stopped:
+		t.Fatal("stop must wait for the running expire")
+	default:
+	}
+	close(release)
+	<-stopped
+
+	if !pending.stopped.Load() {
+		t.Error("the pending deadline must be stopped")
+	}
+	// deadlines are not scheduled after stop
+	timer.observe(ctx, situation(time.Now().Add(time.Minute)))
+	select {
+	case <-clock.scheduled:
+		t.Error("deadline must not be scheduled after stop")
+	default:
+	}
+}
@@ -8,6 +8,8 @@ type (
 	Factory interface {
 		LoginInteractor() LoginInteractor
 		PokerInteractor() PokerInteractor
+		// Close stops the background work of the interactors. Call it before closing the gateways.
+		Close()
 	}
 
 	factory struct {
@@ -16,10 +18,10 @@ type (
 	}
 )
 
-func NewFactory(rFactory ports.RepositoriesFactory) Factory {
+func NewFactory(rFactory ports.RepositoriesFactory, pokerMetrics ports.PokerMetrics) Factory {
 	return &factory{
 		loginInteractor: NewLoginInteractor(rFactory),
-		pokerInteractor: NewPokerInteractor(rFactory),
+		pokerInteractor: NewPokerInteractor(rFactory, pokerMetrics),
 	}
 }
 
@@ -30,3 +32,7 @@ func (f factory) LoginInteractor() LoginInteractor {
 func (f factory) PokerInteractor() PokerInteractor {
 	return f.pokerInteractor
 }
+
+func (f factory) Close() {
+	f.pokerInteractor.Close()
+}
@@ -3,6 +3,7 @@ package interactors
 
 import (
 	"context"
+	"time"
 
 	"github.com/swallowarc/porker-proto/pkg/porker"
 	"github.com/swallowarc/porker-rpc/internal/domains/room"
@@ -13,15 +14,49 @@ type (
 	LoginInteractor interface {
 		Login(ctx context.Context, login *porker.Login) (*porker.Login, error)
 		Logout(ctx context.Context, login *porker.Login) error
+		// Authenticate returns errs.UnauthenticatedError if the session of the login is not valid.
+		Authenticate(ctx context.Context, login *porker.Login) error
+		// IssueFeedTicket authenticates the login and returns a ticket standing for it on the room feed of the room,
+		// for clients that cannot send the session in headers.
+		IssueFeedTicket(ctx context.Context, roomID room.ID, login *porker.Login) (string, error)
+		// UseFeedTicket returns the login of the ticket issued by IssueFeedTicket.
+		// It returns errs.UnauthenticatedError if the ticket is not valid or has been used.
+		UseFeedTicket(ctx context.Context, roomID room.ID, ticket string) (*porker.Login, error)
 	}
 
 	PokerInteractor interface {
-		Create(ctx context.Context, loginID string) (room.ID, error)
+		// Close stops the deadline timers and waits for the running reveals. Call it before closing the gateways.
+		Close()
+		Create(ctx context.Context, loginID string, deck *porker.Deck) (room.ID, error)
 		CanEnter(ctx context.Context, roomID room.ID) (bool, error)
-		Enter(ctx context.Context, roomID room.ID, loginID string) (ports.PokerListener, error)
+		// Enter adds the login to the room and returns a listener of the room, which stops when ctx is done.
+		// Observers receive the situations but have no ballot.
+		Enter(ctx context.Context, roomID room.ID, loginID string, role porker.Role) (ports.PokerListener, error)
+		// Watch returns a listener of the room without entering it, which stops when ctx is done
+		// or nobody is in the room anymore.
+		Watch(ctx context.Context, roomID room.ID) (ports.PokerListener, error)
 		Leave(ctx context.Context, roomID room.ID, loginID string) error
-		Voting(ctx context.Context, roomID room.ID, loginID string, point porker.Point) error
+		// ChangeRole returns errs.PermissionDeniedError if the login is not a facilitator of the room.
+		ChangeRole(ctx context.Context, roomID room.ID, loginID, memberLoginID string, role porker.Role) error
+		Voting(ctx context.Context, roomID room.ID, loginID string, point porker.Point, card string) error
 		VoteCounting(ctx context.Context, roomID room.ID, loginID string) error
-		Reset(ctx context.Context, roomID room.ID) error
+		// StartTimer sets the deadline of the voting, when the ballots are revealed even if some members have not voted.
+		StartTimer(ctx context.Context, roomID room.ID, loginID string, duration time.Duration) error
+		// Reset records the estimate on the current story and starts the next round with the next story.
+		// Without the estimate, the most voted card is recorded if it is the only one and has a value,
+		// and otherwise the current story is voted again.
+		// It returns errs.PermissionDeniedError if the login is not a member of the room,
+		// or is not a facilitator while the room has the current story or the estimate is given,
+		// and errs.InvalidStateError if the estimate is given while the room has no current story.
+		Reset(ctx context.Context, roomID room.ID, loginID, estimate string) error
+		// AddStory, MoveStory and RemoveStory return errs.PermissionDeniedError if the login is not a facilitator of the room.
+		AddStory(ctx context.Context, roomID room.ID, loginID string, s *porker.Story) (*porker.Story, error)
+		MoveStory(ctx context.Context, roomID room.ID, loginID, storyID string, index int) error
+		RemoveStory(ctx context.Context, roomID room.ID, loginID, storyID string) error
+		// History returns errs.PermissionDeniedError if the login is not a member of the room.
+		History(ctx context.Context, roomID room.ID, loginID string) ([]*porker.RoomSnapshot, error)
+		// ArchivedRounds returns the revealed rounds of the room, also after it has timed out.
+		// It returns errs.PermissionDeniedError if the login is neither a member of the room nor has voted in the rounds.
+		ArchivedRounds(ctx context.Context, roomID room.ID, loginID string) ([]*porker.ArchivedRound, error)
 	}
 )
@@ -2,9 +2,11 @@ package interactors
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/swallowarc/porker-proto/pkg/porker"
 	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
 	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
 	"golang.org/x/xerrors"
 )
@@ -35,12 +37,29 @@ func (li *loginInteractor) Login(ctx context.Context, login *porker.Login) (*por
 	case err != nil:
 		return nil, xerrors.Errorf("failed to FindByID: %w", err)
 
-	case login.SessionId != "" && login.SessionId != registeredLogin.SessionId:
+	case login.SessionId == "":
+		active, err := li.loginRepo.IsActive(ctx, login.LoginId)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to IsActive: %w", err)
+		}
+		if active {
+			// session を知らない他人に使用中の login を渡さない
+			return nil, errs.NewAlreadyExistsError(fmt.Sprintf("login_id is already in use. login_id: %s", login.LoginId))
+		}
+
+		// 使われていない session は新しい session に置き換えて、session を失った本人が戻れるようにする
+		renewedLogin, err := li.loginRepo.Renew(ctx, login.LoginId)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to Renew: %w", err)
+		}
+		return renewedLogin, nil
+
+	case login.SessionId != registeredLogin.SessionId:
 		return nil, errs.NewPreConditionError("session id does not match")
 	}
 
-	if err := li.loginRepo.ReLogin(ctx, login); err != nil {
-		return nil, xerrors.Errorf("failed to ReLogin: %w", err)
+	if err := li.loginRepo.Touch(ctx, login); err != nil {
+		return nil, xerrors.Errorf("failed to Touch: %w", err)
 	}
 
 	return registeredLogin, nil
@@ -52,3 +71,48 @@ func (li *loginInteractor) Logout(ctx context.Context, login *porker.Login) erro
 	}
 	return nil
 }
+
+func (li *loginInteractor) Authenticate(ctx context.Context, login *porker.Login) error {
+	registeredLogin, err := li.loginRepo.FindByID(ctx, login.LoginId)
+	switch {
+	case errs.IsNotFoundError(err):
+		return errs.NewUnauthenticatedError("login does not exist")
+	case err != nil:
+		return xerrors.Errorf("failed to FindByID: %w", err)
+	case login.SessionId == "" || login.SessionId != registeredLogin.SessionId:
+		return errs.NewUnauthenticatedError("session id does not match")
+	}
+
+	if err := li.loginRepo.Touch(ctx, login); err != nil {
+		return xerrors.Errorf("failed to Touch: %w", err)
+	}
+	return nil
+}
+
+func (li *loginInteractor) IssueFeedTicket(ctx context.Context, roomID room.ID, login *porker.Login) (string, error) {
+	if err := li.Authenticate(ctx, login); err != nil {
+		return "", xerrors.Errorf("failed to Authenticate: %w", err)
+	}
+
+	ticket, err := li.loginRepo.NewFeedTicket(ctx, roomID, login)
+	if err != nil {
+		return "", xerrors.Errorf("failed to NewFeedTicket: %w", err)
+	}
+	return ticket, nil
+}
+
+func (li *loginInteractor) UseFeedTicket(ctx context.Context, roomID room.ID, ticket string) (*porker.Login, error) {
+	login, err := li.loginRepo.UseFeedTicket(ctx, roomID, ticket)
+	switch {
+	case errs.IsNotFoundError(err):
+		return nil, errs.NewUnauthenticatedError("ticket is not valid")
+	case err != nil:
+		return nil, xerrors.Errorf("failed to UseFeedTicket: %w", err)
+	}
+
+	// ticket の発行後に logout や session の置き換えがあれば使えない
+	if err := li.Authenticate(ctx, login); err != nil {
+		return nil, xerrors.Errorf("failed to Authenticate: %w", err)
+	}
+	return login, nil
+}
@@ -0,0 +1,132 @@
+package interactors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/repositories"
+)
+
+func TestLoginInteractor_Authenticate(t *testing.T) {
+	ctx := context.Background()
+	li := NewLoginInteractor(repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false)))
+
+	login, err := li.Login(ctx, &porker.Login{LoginId: "alice"})
+	if err != nil {
+		t.Fatalf("failed to Login: %v", err)
+	}
+	if err := li.Authenticate(ctx, login); err != nil {
+		t.Errorf("expected authenticated, actual %v", err)
+	}
+
+	// 再ログインでは同じ session が返る
+	relogin, err := li.Login(ctx, login)
+	if err != nil {
+		t.Fatalf("failed to Login again: %v", err)
+	}
+	if relogin.SessionId != login.SessionId {
+		t.Errorf("expected %s, actual %s", login.SessionId, relogin.SessionId)
+	}
+
+	if _, err := li.Login(ctx, &porker.Login{LoginId: "alice"}); !errs.IsAlreadyExistsError(err) {
+		t.Errorf("expected AlreadyExistsError for login without session, actual %v", err)
+	}
+
+	for name, l := range map[string]*porker.Login{
+		"session mismatch": {LoginId: "alice", SessionId: "other"},
+		"empty session":    {LoginId: "alice"},
+		"unknown login":    {LoginId: "bob", SessionId: login.SessionId},
+	} {
+		if err := li.Authenticate(ctx, l); !errs.IsUnauthenticatedError(err) {
+			t.Errorf("%s: expected UnauthenticatedError, actual %v", name, err)
+		}
+	}
+
+	if err := li.Logout(ctx, login); err != nil {
+		t.Fatalf("failed to Logout: %v", err)
+	}
+	if err := li.Authenticate(ctx, login); !errs.IsUnauthenticatedError(err) {
+		t.Errorf("expected UnauthenticatedError after Logout, actual %v", err)
+	}
+}
+
+func TestLoginInteractor_Login_Takeover(t *testing.T) {
+	ctx := context.Background()
+	gwFactory := newMemoryFactory(t)
+	li := NewLoginInteractor(repositories.NewFactory(gwFactory, repositories.SituationFormatJSON, room.NewKeyStrategy(false)))
+
+	lost, err := li.Login(ctx, &porker.Login{LoginId: "alice"})
+	if err != nil {
+		t.Fatalf("failed to Login: %v", err)
+	}
+	if _, err := li.Login(ctx, &porker.Login{LoginId: "alice"}); !errs.IsAlreadyExistsError(err) {
+		t.Fatalf("expected AlreadyExistsError while the session is in use, actual %v", err)
+	}
+
+	// session を失ったまま sessionIdleTimeout が過ぎた状態にする
+	if err := gwFactory.MemDBClient().Del(ctx, "porker_login_active:alice"); err != nil {
+		t.Fatalf("failed to Del: %v", err)
+	}
+
+	recovered, err := li.Login(ctx, &porker.Login{LoginId: "alice"})
+	if err != nil {
+		t.Fatalf("expected a new session for the idle login, actual %v", err)
+	}
+	if recovered.SessionId == "" || recovered.SessionId == lost.SessionId {
+		t.Errorf("expected a new session, actual %q", recovered.SessionId)
+	}
+	if err := li.Authenticate(ctx, recovered); err != nil {
+		t.Errorf("expected authenticated, actual %v", err)
+	}
+	if err := li.Authenticate(ctx, lost); !errs.IsUnauthenticatedError(err) {
+		t.Errorf("expected UnauthenticatedError for the replaced session, actual %v", err)
+	}
+
+	// 新しい session が使われている間は再び奪えない
+	if _, err := li.Login(ctx, &porker.Login{LoginId: "alice"}); !errs.IsAlreadyExistsError(err) {
+		t.Errorf("expected AlreadyExistsError after recovery, actual %v", err)
+	}
+}
+
+func TestLoginInteractor_FeedTicket(t *testing.T) {
+	ctx := context.Background()
+	li := NewLoginInteractor(repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false)))
+
+	login, err := li.Login(ctx, &porker.Login{LoginId: "alice"})
+	if err != nil {
+		t.Fatalf("failed to Login: %v", err)
+	}
+	if _, err := li.IssueFeedTicket(ctx, "12345", &porker.Login{LoginId: "alice", SessionId: "other"}); !errs.IsUnauthenticatedError(err) {
+		t.Errorf("expected UnauthenticatedError for a wrong session, actual %v", err)
+	}
+
+	ticket, err := li.IssueFeedTicket(ctx, "12345", login)
+	if err != nil {
+		t.Fatalf("failed to IssueFeedTicket: %v", err)
+	}
+	if _, err := li.UseFeedTicket(ctx, "99999", ticket); !errs.IsUnauthenticatedError(err) {
+		t.Errorf("expected UnauthenticatedError for another room, actual %v", err)
+	}
+	used, err := li.UseFeedTicket(ctx, "12345", ticket)
+	if err != nil || used.LoginId != "alice" {
+		t.Fatalf("expected the ticket of alice, actual %v, err: %v", used, err)
+	}
+	// ticket は一度しか使えない
+	if _, err := li.UseFeedTicket(ctx, "12345", ticket); !errs.IsUnauthenticatedError(err) {
+		t.Errorf("expected UnauthenticatedError for a used ticket, actual %v", err)
+	}
+
+	ticket, err = li.IssueFeedTicket(ctx, "12345", login)
+	if err != nil {
+		t.Fatalf("failed to IssueFeedTicket: %v", err)
+	}
+	if err := li.Logout(ctx, login); err != nil {
+		t.Fatalf("failed to Logout: %v", err)
+	}
+	if _, err := li.UseFeedTicket(ctx, "12345", ticket); !errs.IsUnauthenticatedError(err) {
+		t.Errorf("expected UnauthenticatedError after Logout, actual %v", err)
+	}
+}
@@ -2,29 +2,81 @@ package interactors
 
 import (
 	"context"
+	"fmt"
+	"math/rand"
+	"time"
 
 	"github.com/swallowarc/porker-proto/pkg/porker"
 	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/commons/tracers"
+	"github.com/swallowarc/porker-rpc/internal/domains/deck"
+	"github.com/swallowarc/porker-rpc/internal/domains/member"
 	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/domains/round"
+	"github.com/swallowarc/porker-rpc/internal/domains/statistics"
+	"github.com/swallowarc/porker-rpc/internal/domains/story"
 	"github.com/swallowarc/porker-rpc/internal/usecases/listener"
 	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
 	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxUpdateRetries   = 20
+	updateRetryBackoff = 10 * time.Millisecond
+
+	MinTimerDuration = time.Second
+	MaxTimerDuration = time.Hour
+	expireTimeout    = 10 * time.Second
 )
 
 type (
 	pokerInteractor struct {
-		pokerRepo ports.PokerRepository
+		pokerRepo   ports.PokerRepository
+		archiveRepo ports.ArchiveRepository
+		roomHub     listener.RoomHub
+		metrics     ports.PokerMetrics
+		timer       *deadlineTimer
 	}
 )
 
-func NewPokerInteractor(rFactory ports.RepositoriesFactory) PokerInteractor {
-	return &pokerInteractor{
-		pokerRepo: rFactory.PokerRepository(),
+var (
+	// errUnchanged is returned by the modify function of updateSituation to skip publishing the situation.
+	errUnchanged = xerrors.New("situation is unchanged")
+)
+
+func NewPokerInteractor(rFactory ports.RepositoriesFactory, pokerMetrics ports.PokerMetrics) PokerInteractor {
+	return newPokerInteractor(rFactory, pokerMetrics, realClock{})
+}
+
+func newPokerInteractor(rFactory ports.RepositoriesFactory, pokerMetrics ports.PokerMetrics, c clock) *pokerInteractor {
+	bi := &pokerInteractor{
+		pokerRepo:   rFactory.PokerRepository(),
+		archiveRepo: rFactory.ArchiveRepository(),
+		metrics:     pokerMetrics,
 	}
+	bi.timer = newDeadlineTimer(bi.expire, c)
+	bi.roomHub = listener.NewRoomHub(rFactory.PokerRepository(), pokerMetrics, bi.timer.observe)
+	return bi
+}
+
+func (bi *pokerInteractor) Close() {
+	bi.timer.stop()
 }
 
-func (bi *pokerInteractor) Create(ctx context.Context, loginID string) (room.ID, error) {
-	roomID, err := bi.pokerRepo.Create(ctx, loginID)
+func (bi *pokerInteractor) Create(ctx context.Context, loginID string, d *porker.Deck) (room.ID, error) {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.Create", tracers.LoginID(loginID))
+	defer span.End()
+
+	newDeck, err := deck.New(d)
+	if err != nil {
+		return "", xerrors.Errorf("failed to deck.New: %w", err)
+	}
+
+	roomID, err := bi.pokerRepo.Create(ctx, loginID, newDeck)
 	if err != nil {
 		return "", xerrors.Errorf("failed to Create: %w", err)
 	}
@@ -33,6 +85,9 @@ func (bi *pokerInteractor) Create(ctx context.Context, loginID string) (room.ID,
 }
 
 func (bi *pokerInteractor) CanEnter(ctx context.Context, roomID room.ID) (bool, error) {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.CanEnter", tracers.RoomID(roomID.String()))
+	defer span.End()
+
 	_, _, err := bi.pokerRepo.ReadStreamLatest(ctx, roomID)
 	if err != nil {
 		if errs.IsNotFoundError(err) {
@@ -44,39 +99,41 @@ func (bi *pokerInteractor) CanEnter(ctx context.Context, roomID room.ID) (bool,
 	return true, nil
 }
 
-func (bi *pokerInteractor) Enter(ctx context.Context, roomID room.ID, loginID string) (ports.PokerListener, error) {
+func (bi *pokerInteractor) Enter(ctx context.Context, roomID room.ID, loginID string, role porker.Role) (ports.PokerListener, error) {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.Enter", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
 	if err := bi.pokerRepo.Enter(ctx, roomID, loginID); err != nil {
 		return nil, xerrors.Errorf("failed to Enter: %w", err)
 	}
 
-	_, ps, err := bi.pokerRepo.ReadStreamLatest(ctx, roomID)
-	if err != nil {
-		return nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		return member.Join(ps, loginID, role)
+	}); err != nil {
+		return nil, xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
-	var isExists bool
-	for _, ballot := range ps.Ballots {
-		if ballot.LoginId == loginID {
-			isExists = true
-			break
-		}
-	}
+	return bi.roomHub.Subscribe(ctx, roomID, loginID), nil
+}
 
-	if !isExists {
-		ps.Ballots = append(ps.Ballots, &porker.Ballot{
-			LoginId: loginID,
-			Point:   porker.Point_POINT_UNKNOWN,
-		})
-	}
+func (bi *pokerInteractor) Watch(ctx context.Context, roomID room.ID) (ports.PokerListener, error) {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.Watch", tracers.RoomID(roomID.String()))
+	defer span.End()
 
-	if err := bi.pokerRepo.Update(ctx, ps); err != nil {
-		return nil, xerrors.Errorf("failed to Update: %w", err)
+	if _, _, err := bi.pokerRepo.ReadStreamLatest(ctx, roomID); err != nil {
+		if errs.IsNotFoundError(err) {
+			return nil, errs.NewNotFoundError(fmt.Sprintf("room does not exist. room_id: %s", roomID))
+		}
+		return nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
 	}
 
-	return listener.NewPokerListener(roomID, loginID, bi.pokerRepo), nil
+	return bi.roomHub.Watch(ctx, roomID), nil
 }
 
 func (bi *pokerInteractor) Leave(ctx context.Context, roomID room.ID, loginID string) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.Leave", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
 	if err := bi.pokerRepo.Leave(ctx, roomID, loginID); err != nil {
 		return xerrors.Errorf("failed to Leave: %w", err)
 	}
@@ -94,105 +151,385 @@ func (bi *pokerInteractor) Leave(ctx context.Context, roomID room.ID, loginID st
 	}
 
 	// まだRoomに人がいる場合は退室者をSituationから削除
-	_, ps, err := bi.pokerRepo.ReadStreamLatest(ctx, roomID)
-	if err != nil {
-		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		member.Remove(ps, loginID)
+		return nil
+	}); err != nil {
+		return xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
-	newBallots := make([]*porker.Ballot, 0, len(ps.Ballots))
-	for _, b := range ps.Ballots {
-		if b.LoginId != loginID {
-			newBallots = append(newBallots, b)
+	return nil
+}
+
+func (bi *pokerInteractor) ChangeRole(ctx context.Context, roomID room.ID, loginID, memberLoginID string, role porker.Role) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.ChangeRole", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if err := requireFacilitator(ps, loginID); err != nil {
+			return err
 		}
+		return member.ChangeRole(ps, memberLoginID, role)
+	}); err != nil {
+		return xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
-	ps.Ballots = newBallots
-	if err := bi.pokerRepo.Update(ctx, ps); err != nil {
-		return xerrors.Errorf("failed to bt Update: %w", err)
+	return nil
+}
+
+func (bi *pokerInteractor) Voting(ctx context.Context, roomID room.ID, loginID string, point porker.Point, card string) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.Voting", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if ps.State != porker.RoomState_ROOM_STATE_TURN_DOWN {
+			return errs.NewInvalidStateError(fmt.Sprintf(
+				"cannot vote in any state other than TURN_DOWN. room_id: %s, state: %s", roomID, ps.State))
+		}
+
+		// pointとcardのどちらで投票されてもRoomのDeckにあるカードか検証して両方を記録する
+		votedPoint, votedCard, err := deck.Resolve(deck.OrDefault(ps.Deck), point, card)
+		if err != nil {
+			return err
+		}
+
+		var isExists bool
+		var votedCount, notVoterCount int
+		for _, ballot := range ps.Ballots {
+			if ballot.LoginId == loginID {
+				ballot.Point = votedPoint
+				ballot.Card = votedCard
+				isExists = true
+			}
+			if ballot.Point != porker.Point_POINT_UNKNOWN {
+				votedCount++
+			}
+			if ballot.Point == porker.Point_NOT_VOTE {
+				notVoterCount++
+			}
+		}
+
+		if !isExists {
+			return errs.NewPermissionDeniedError(fmt.Sprintf("login_id: %s is not found in room. room_id: %s", loginID, roomID))
+		}
+
+		switch len(ps.Ballots) {
+		case notVoterCount:
+			// 全員 not voter の場合はOpenしない
+		case votedCount:
+			ps.State = porker.RoomState_ROOM_STATE_OPEN
+		}
+		return nil
+	}); err != nil {
+		return xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
+	bi.metrics.Voted()
 	return nil
 }
 
-func (bi *pokerInteractor) Voting(ctx context.Context, roomID room.ID, loginID string, point porker.Point) error {
-	_, ps, err := bi.pokerRepo.ReadStreamLatest(ctx, roomID)
-	if err != nil {
-		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+func (bi *pokerInteractor) VoteCounting(ctx context.Context, roomID room.ID, loginID string) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.VoteCounting", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if ps.State != porker.RoomState_ROOM_STATE_TURN_DOWN {
+			return errs.NewInvalidStateError(fmt.Sprintf(
+				"cannot vote counting in any state other than TURN_DOWN. room_id: %s, state: %s", roomID, ps.State))
+		}
+
+		ps.State = porker.RoomState_ROOM_STATE_OPEN
+		return nil
+	}); err != nil {
+		return xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
-	if ps.State != porker.RoomState_ROOM_STATE_TURN_DOWN {
-		return xerrors.Errorf(
-			"cannot vote in any state other than TURN_DOWN. room_id: %s, state: %s", roomID, ps.State)
+	return nil
+}
+
+func (bi *pokerInteractor) StartTimer(ctx context.Context, roomID room.ID, loginID string, duration time.Duration) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.StartTimer", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	if duration < MinTimerDuration || duration > MaxTimerDuration {
+		return errs.NewInvalidArgumentError(fmt.Sprintf("duration must be %s to %s: %s", MinTimerDuration, MaxTimerDuration, duration))
 	}
 
-	var isExists bool
-	var votedCount, notVoterCount int
-	for _, ballot := range ps.Ballots {
-		if ballot.LoginId == loginID {
-			ballot.Point = point
-			isExists = true
+	var deadline time.Time
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if err := requireFacilitator(ps, loginID); err != nil {
+			return err
 		}
-		if ballot.Point != porker.Point_POINT_UNKNOWN {
-			votedCount++
+		if ps.State != porker.RoomState_ROOM_STATE_TURN_DOWN {
+			return errs.NewInvalidStateError(fmt.Sprintf(
+				"cannot start the timer in any state other than TURN_DOWN. room_id: %s, state: %s", roomID, ps.State))
 		}
-		if ballot.Point == porker.Point_NOT_VOTE {
-			notVoterCount++
-		}
-	}
 
-	if !isExists {
-		return xerrors.Errorf("login_id: %s is not found in room. room_id: %s", loginID, roomID)
+		deadline = time.Now().Add(duration)
+		ps.Deadline = timestamppb.New(deadline)
+		return nil
+	}); err != nil {
+		return xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
-	switch len(ps.Ballots) {
-	case notVoterCount:
-		// 全員 not voter の場合はOpenしない
-	case votedCount:
+	// 他のインスタンスもRoomを購読していれば同じ期限を予約する
+	bi.timer.schedule(ctx, roomID, deadline)
+	return nil
+}
+
+// expire reveals the ballots if the room still has the deadline. Instances sharing the room may call it at the same time,
+// and only the first one updates the room thanks to CompareAndUpdate.
+func (bi *pokerInteractor) expire(ctx context.Context, roomID room.ID, deadline time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, expireTimeout)
+	defer cancel()
+	ctx, span := tracers.Start(ctx, "PokerInteractor.expire", tracers.RoomID(roomID.String()))
+	defer span.End()
+
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if ps.State != porker.RoomState_ROOM_STATE_TURN_DOWN || ps.Deadline == nil || !ps.Deadline.AsTime().Equal(deadline) {
+			return errUnchanged
+		}
+
 		ps.State = porker.RoomState_ROOM_STATE_OPEN
+		return nil
+	}); err != nil && !errs.IsNotFoundError(err) {
+		loggers.Logger(ctx).Warn("failed to reveal the room at the deadline", zap.String("room_id", roomID.String()), zap.Error(err))
 	}
+}
+
+func (bi *pokerInteractor) Reset(ctx context.Context, roomID room.ID, loginID, estimate string) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.Reset", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if _, ok := member.RoleOf(ps, loginID); !ok {
+			return errs.NewPermissionDeniedError(fmt.Sprintf("login_id: %s is not found in room. room_id: %s", loginID, roomID))
+		}
+		// 見積もりの記録とストーリーの進行は facilitator だけができる
+		if _, ok := story.Current(ps); ok || estimate != "" {
+			if err := requireFacilitator(ps, loginID); err != nil {
+				return err
+			}
+		}
+
+		d := deck.OrDefault(ps.Deck)
+		agreed := estimate
+		if agreed != "" {
+			if _, ok := deck.Find(d, agreed); !ok {
+				return errs.NewInvalidArgumentError(fmt.Sprintf("estimate is not in the deck: %q", agreed))
+			}
+		} else if _, ok := story.Current(ps); ok {
+			// 見積もりの指定がなければ、全員一致か最多のカードが一つに決まった時だけ値のあるカードを記録する
+			agreed = mostVotedCard(d, ps.GetSummary())
+		}
+		if err := story.Advance(ps, agreed); err != nil {
+			return err
+		}
 
-	if err := bi.pokerRepo.Update(ctx, ps); err != nil {
-		return xerrors.Errorf("failed to bt Update: %w", err)
+		ps.State = porker.RoomState_ROOM_STATE_TURN_DOWN
+		ps.Deadline = nil
+		ps.RoundStartedAt = timestamppb.Now()
+		for i, ballot := range ps.Ballots {
+			if ballot.Point != porker.Point_NOT_VOTE {
+				ps.Ballots[i].Point = porker.Point_POINT_UNKNOWN
+				ps.Ballots[i].Card = ""
+			}
+		}
+		return nil
+	}); err != nil {
+		return xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
+	bi.metrics.Reset()
 	return nil
 }
 
-func (bi *pokerInteractor) VoteCounting(ctx context.Context, roomID room.ID, loginID string) error {
-	_, ps, err := bi.pokerRepo.ReadStreamLatest(ctx, roomID)
+// mostVotedCard returns the card voted the most if it is the only one and has a value, otherwise empty.
+func mostVotedCard(d *porker.Deck, summary *porker.VoteSummary) string {
+	if !summary.GetConsensus() && len(summary.GetMode()) != 1 {
+		return ""
+	}
+	if c, ok := deck.Find(d, summary.Mode[0]); !ok || c.Value == nil {
+		return ""
+	}
+	return summary.Mode[0]
+}
+
+func (bi *pokerInteractor) AddStory(ctx context.Context, roomID room.ID, loginID string, s *porker.Story) (*porker.Story, error) {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.AddStory", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	newStory, err := story.New(s)
 	if err != nil {
-		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+		return nil, xerrors.Errorf("failed to story.New: %w", err)
 	}
 
-	if ps.State != porker.RoomState_ROOM_STATE_TURN_DOWN {
-		return xerrors.Errorf(
-			"cannot vote counting in any state other than TURN_DOWN. room_id: %s, state: %s", roomID, ps.State)
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if err := requireFacilitator(ps, loginID); err != nil {
+			return err
+		}
+		return story.Add(ps, newStory)
+	}); err != nil {
+		return nil, xerrors.Errorf("failed to updateSituation: %w", err)
+	}
+
+	return newStory, nil
+}
+
+func (bi *pokerInteractor) MoveStory(ctx context.Context, roomID room.ID, loginID, storyID string, index int) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.MoveStory", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if err := requireFacilitator(ps, loginID); err != nil {
+			return err
+		}
+		return story.Move(ps, storyID, index)
+	}); err != nil {
+		return xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
-	ps.State = porker.RoomState_ROOM_STATE_OPEN
-	if err := bi.pokerRepo.Update(ctx, ps); err != nil {
-		return xerrors.Errorf("failed to bt Update: %w", err)
+	return nil
+}
+
+func (bi *pokerInteractor) RemoveStory(ctx context.Context, roomID room.ID, loginID, storyID string) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.RemoveStory", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	if err := bi.updateSituation(ctx, roomID, func(ps *porker.PokerSituation) error {
+		if err := requireFacilitator(ps, loginID); err != nil {
+			return err
+		}
+		return story.Remove(ps, storyID)
+	}); err != nil {
+		return xerrors.Errorf("failed to updateSituation: %w", err)
 	}
 
 	return nil
 }
 
-func (bi *pokerInteractor) Reset(ctx context.Context, roomID room.ID) error {
-	_, ps, err := bi.pokerRepo.ReadStreamLatest(ctx, roomID)
+func (bi *pokerInteractor) History(ctx context.Context, roomID room.ID, loginID string) ([]*porker.RoomSnapshot, error) {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.History", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	isMember, err := bi.pokerRepo.IsExistsInRoom(ctx, roomID, loginID)
 	if err != nil {
-		return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+		return nil, xerrors.Errorf("failed to IsExistsInRoom: %w", err)
+	}
+	if !isMember {
+		return nil, errs.NewPermissionDeniedError(fmt.Sprintf("login_id: %s is not found in room. room_id: %s", loginID, roomID))
 	}
 
-	ps.State = porker.RoomState_ROOM_STATE_TURN_DOWN
-	for i, ballot := range ps.Ballots {
-		if ballot.Point != porker.Point_NOT_VOTE {
-			ps.Ballots[i].Point = porker.Point_POINT_UNKNOWN
+	snapshots, err := bi.pokerRepo.ListHistory(ctx, roomID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ListHistory: %w", err)
+	}
+	if len(snapshots) == 0 {
+		return nil, errs.NewNotFoundError(fmt.Sprintf("room does not exist. room_id: %s", roomID))
+	}
+
+	return snapshots, nil
+}
+
+func (bi *pokerInteractor) ArchivedRounds(ctx context.Context, roomID room.ID, loginID string) ([]*porker.ArchivedRound, error) {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.ArchivedRounds", tracers.RoomID(roomID.String()), tracers.LoginID(loginID))
+	defer span.End()
+
+	rounds, err := bi.archiveRepo.ListByRoom(ctx, roomID)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to ListByRoom: %w", err)
+	}
+
+	// 部屋がタイムアウトした後も、投票したことのある人は見返せる
+	allowed := false
+	for _, rd := range rounds {
+		if rd.HasBallot(loginID) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		isMember, err := bi.pokerRepo.IsExistsInRoom(ctx, roomID, loginID)
+		if err != nil {
+			return nil, xerrors.Errorf("failed to IsExistsInRoom: %w", err)
+		}
+		if !isMember {
+			return nil, errs.NewPermissionDeniedError(fmt.Sprintf("login_id: %s has not been in room. room_id: %s", loginID, roomID))
 		}
 	}
 
-	if err := bi.pokerRepo.Update(ctx, ps); err != nil {
-		return xerrors.Errorf("failed to Update: %w", err)
+	result := make([]*porker.ArchivedRound, 0, len(rounds))
+	for _, rd := range rounds {
+		result = append(result, rd.ToProto())
+	}
+	return result, nil
+}
+
+// updateSituation applies modify to the latest situation of the room and publishes it
+// only if nobody else has updated the room in the meantime. On conflict it starts over from the latest situation.
+func (bi *pokerInteractor) updateSituation(ctx context.Context, roomID room.ID, modify func(ps *porker.PokerSituation) error) error {
+	ctx, span := tracers.Start(ctx, "PokerInteractor.updateSituation", tracers.RoomID(roomID.String()))
+	defer span.End()
+
+	for i := 0; i < maxUpdateRetries; i++ {
+		span.SetAttributes(attribute.Int("attempt", i+1))
+		msgID, ps, err := bi.pokerRepo.ReadStreamLatest(ctx, roomID)
+		if err != nil {
+			return xerrors.Errorf("failed to ReadStreamLatest: %w", err)
+		}
+
+		previousState := ps.State
+		if err := modify(ps); err != nil {
+			if xerrors.Is(err, errUnchanged) {
+				return nil
+			}
+			return err
+		}
+
+		// 公開時に集計してSituationに載せ、全クライアントで同じ数字を表示する
+		switch {
+		case previousState != porker.RoomState_ROOM_STATE_OPEN && ps.State == porker.RoomState_ROOM_STATE_OPEN:
+			ps.Summary = statistics.Summarize(deck.OrDefault(ps.Deck), ps.Ballots)
+			ps.Deadline = nil
+		case ps.State != porker.RoomState_ROOM_STATE_OPEN:
+			ps.Summary = nil
+		}
+
+		err = bi.pokerRepo.CompareAndUpdate(ctx, msgID, ps)
+		if errs.IsConflictError(err) {
+			// 同時更新した他のリクエストとぶつかり続けないように少しずらして再試行する
+			select {
+			case <-ctx.Done():
+				return xerrors.Errorf("failed to retry updating the room: %w", ctx.Err())
+			case <-time.After(time.Duration(rand.Int63n(int64(updateRetryBackoff) * int64(i+1)))):
+			}
+			continue
+		}
+		if err != nil {
+			return xerrors.Errorf("failed to CompareAndUpdate: %w", err)
+		}
+
+		if previousState != porker.RoomState_ROOM_STATE_OPEN && ps.State == porker.RoomState_ROOM_STATE_OPEN {
+			bi.metrics.Revealed()
+			bi.archive(ctx, ps)
+		}
+		return nil
 	}
 
+	return errs.NewConflictError(fmt.Sprintf("gave up updating the room due to conflicts. room_id: %s", roomID))
+}
+
+// requireFacilitator returns errs.PermissionDeniedError if the login is not a facilitator of the room.
+func requireFacilitator(ps *porker.PokerSituation, loginID string) error {
+	if !member.IsFacilitator(ps, loginID) {
+		return errs.NewPermissionDeniedError(fmt.Sprintf("login_id: %s is not a facilitator of the room. room_id: %s", loginID, ps.RoomId))
+	}
 	return nil
 }
+
+// archive records the revealed round. A failure is only logged because the room has already been updated.
+func (bi *pokerInteractor) archive(ctx context.Context, ps *porker.PokerSituation) {
+	if err := bi.archiveRepo.Save(ctx, round.New(ps, time.Now())); err != nil {
+		loggers.Logger(ctx).Warn("failed to archive the round", zap.String("room_id", ps.RoomId), zap.Error(err))
+	}
+}
@@ -0,0 +1,668 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/tracers"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/memory"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/sqlite"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/repositories"
+	mock_ports "github.com/swallowarc/porker-rpc/internal/tests/mocks/ports"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type (
+	memoryFactory struct {
+		memDBCli gateways.MemDBClient
+		rdbCli   gateways.RDBClient
+	}
+)
+
+func (f memoryFactory) MemDBClient() gateways.MemDBClient {
+	return f.memDBCli
+}
+
+func (f memoryFactory) StreamClient() gateways.StreamClient {
+	return f.memDBCli
+}
+
+func (f memoryFactory) RDBClient() gateways.RDBClient {
+	return f.rdbCli
+}
+
+func (f memoryFactory) Close() error {
+	return nil
+}
+
+func newMemoryFactory(t *testing.T) memoryFactory {
+	rdbCli, err := sqlite.NewSQLiteClient(context.Background(), sqlite.Config{Path: ":memory:"})
+	if err != nil {
+		t.Fatalf("failed to NewSQLiteClient: %v", err)
+	}
+	t.Cleanup(func() { _ = rdbCli.Close() })
+
+	return memoryFactory{
+		memDBCli: memory.NewMemoryClient(gateways.StreamRetention{MaxLen: 1}),
+		rdbCli:   rdbCli,
+	}
+}
+
+// newMetrics returns metrics that accept the records of the room hub.
+func newMetrics(t *testing.T) *mock_ports.MockPokerMetrics {
+	m := mock_ports.NewMockPokerMetrics(gomock.NewController(t))
+	m.EXPECT().RoomActivated().AnyTimes()
+	m.EXPECT().RoomDeactivated().AnyTimes()
+	m.EXPECT().ListenerAdded().AnyTimes()
+	m.EXPECT().ListenerRemoved().AnyTimes()
+	m.EXPECT().WatcherAdded().AnyTimes()
+	m.EXPECT().WatcherRemoved().AnyTimes()
+	return m
+}
+
+func TestPokerInteractor_ConcurrentVoting(t *testing.T) {
+	ctx := context.Background()
+	const voters = 12
+
+	rFactory := repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false))
+	pokerRepo := rFactory.PokerRepository()
+	m := newMetrics(t)
+	m.EXPECT().Voted().Times(voters)
+	m.EXPECT().Revealed().Times(1)
+	pi := NewPokerInteractor(rFactory, m)
+
+	roomID, err := pi.Create(ctx, "master", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	for i := 0; i < voters; i++ {
+		if _, err := pi.Enter(ctx, roomID, fmt.Sprintf("login%d", i), porker.Role_ROLE_UNKNOWN); err != nil {
+			t.Fatalf("failed to Enter: %v", err)
+		}
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < voters; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := pi.Voting(ctx, roomID, fmt.Sprintf("login%d", i), porker.Point_POINT_3, ""); err != nil {
+				t.Errorf("failed to Voting: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	_, ps, err := pokerRepo.ReadStreamLatest(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	for _, b := range ps.Ballots {
+		if b.Point != porker.Point_POINT_3 {
+			t.Errorf("vote of %s was lost", b.LoginId)
+		}
+	}
+	if ps.State != porker.RoomState_ROOM_STATE_OPEN {
+		t.Errorf("expected %s, actual %s", porker.RoomState_ROOM_STATE_OPEN, ps.State)
+	}
+
+	rounds, err := rFactory.ArchiveRepository().ListByRoom(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ListByRoom: %v", err)
+	}
+	if len(rounds) != 1 || len(rounds[0].Ballots) != voters {
+		t.Errorf("expected one archived round with %d ballots, actual %v", voters, rounds)
+	}
+}
+
+func TestPokerInteractor_VotingErrors(t *testing.T) {
+	ctx := context.Background()
+	// failed votes are not counted
+	m := newMetrics(t)
+	m.EXPECT().Revealed().Times(1)
+	pi := NewPokerInteractor(repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false)), m)
+
+	if err := pi.Voting(ctx, "99999", "alice", porker.Point_POINT_1, ""); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError for missing room, actual %v", err)
+	}
+
+	roomID, err := pi.Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	if _, err := pi.Enter(ctx, roomID, "alice", porker.Role_ROLE_UNKNOWN); err != nil {
+		t.Fatalf("failed to Enter: %v", err)
+	}
+
+	if err := pi.Voting(ctx, roomID, "bob", porker.Point_POINT_1, ""); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for non member, actual %v", err)
+	}
+	if err := pi.VoteCounting(ctx, roomID, "alice"); err != nil {
+		t.Fatalf("failed to VoteCounting: %v", err)
+	}
+	if err := pi.Voting(ctx, roomID, "alice", porker.Point_POINT_1, ""); !errs.IsInvalidStateError(err) {
+		t.Errorf("expected InvalidStateError after reveal, actual %v", err)
+	}
+}
+
+func TestPokerInteractor_Deck(t *testing.T) {
+	ctx := context.Background()
+	rFactory := repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false))
+	m := newMetrics(t)
+	m.EXPECT().Voted().Times(2)
+	m.EXPECT().Revealed()
+	m.EXPECT().Reset()
+	pi := NewPokerInteractor(rFactory, m)
+
+	if _, err := pi.Create(ctx, "alice", &porker.Deck{Type: porker.DeckType_DECK_TYPE_CUSTOM}); !errs.IsInvalidArgumentError(err) {
+		t.Errorf("expected InvalidArgumentError for custom deck without cards, actual %v", err)
+	}
+
+	roomID, err := pi.Create(ctx, "alice", &porker.Deck{Type: porker.DeckType_DECK_TYPE_T_SHIRT})
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	for _, loginID := range []string{"alice", "bob"} {
+		if _, err := pi.Enter(ctx, roomID, loginID, porker.Role_ROLE_UNKNOWN); err != nil {
+			t.Fatalf("failed to Enter: %v", err)
+		}
+	}
+
+	if err := pi.Voting(ctx, roomID, "alice", porker.Point_POINT_3, ""); !errs.IsInvalidArgumentError(err) {
+		t.Errorf("expected InvalidArgumentError for point out of the deck, actual %v", err)
+	}
+	if err := pi.Voting(ctx, roomID, "alice", porker.Point_POINT_UNKNOWN, "XXXL"); !errs.IsInvalidArgumentError(err) {
+		t.Errorf("expected InvalidArgumentError for card out of the deck, actual %v", err)
+	}
+	if err := pi.Voting(ctx, roomID, "alice", porker.Point_POINT_UNKNOWN, "M"); err != nil {
+		t.Fatalf("failed to Voting: %v", err)
+	}
+	if err := pi.Voting(ctx, roomID, "bob", porker.Point_POINT_QUESTION, ""); err != nil {
+		t.Fatalf("failed to Voting: %v", err)
+	}
+
+	_, ps, err := rFactory.PokerRepository().ReadStreamLatest(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if ps.GetDeck().GetType() != porker.DeckType_DECK_TYPE_T_SHIRT || len(ps.GetDeck().GetCards()) == 0 {
+		t.Errorf("expected the t-shirt deck in the situation, actual %v", ps.GetDeck())
+	}
+	expected := map[string]*porker.Ballot{
+		"alice": {Point: porker.Point_POINT_CARD, Card: "M"},
+		"bob":   {Point: porker.Point_POINT_QUESTION, Card: "?"},
+	}
+	for _, b := range ps.Ballots {
+		if e := expected[b.LoginId]; b.Point != e.Point || b.Card != e.Card {
+			t.Errorf("%s: expected %s %q, actual %s %q", b.LoginId, e.Point, e.Card, b.Point, b.Card)
+		}
+	}
+	if ps.State != porker.RoomState_ROOM_STATE_OPEN {
+		t.Errorf("expected %s, actual %s", porker.RoomState_ROOM_STATE_OPEN, ps.State)
+	}
+	if sm := ps.GetSummary(); sm.GetVotedCount() != 2 || sm.GetAverage().GetValue() != 3 {
+		t.Errorf("expected the summary of the revealed ballots, actual %v", sm)
+	}
+
+	if err := pi.Reset(ctx, roomID, "alice", ""); err != nil {
+		t.Fatalf("failed to Reset: %v", err)
+	}
+	if _, ps, err = rFactory.PokerRepository().ReadStreamLatest(ctx, roomID); err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if ps.Summary != nil {
+		t.Errorf("summary must be cleared on reset: %v", ps.Summary)
+	}
+}
+
+func TestPokerInteractor_Stories(t *testing.T) {
+	ctx := context.Background()
+	rFactory := repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false))
+	m := newMetrics(t)
+	m.EXPECT().Voted().Times(2)
+	m.EXPECT().Revealed()
+	m.EXPECT().Reset().Times(2)
+	pi := NewPokerInteractor(rFactory, m)
+
+	roomID, err := pi.Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	for _, loginID := range []string{"alice", "bob"} {
+		if _, err := pi.Enter(ctx, roomID, loginID, porker.Role_ROLE_UNKNOWN); err != nil {
+			t.Fatalf("failed to Enter: %v", err)
+		}
+	}
+
+	if _, err := pi.AddStory(ctx, roomID, "bob", &porker.Story{Title: "login"}); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for non master, actual %v", err)
+	}
+	if _, err := pi.AddStory(ctx, roomID, "alice", &porker.Story{Title: " "}); !errs.IsInvalidArgumentError(err) {
+		t.Errorf("expected InvalidArgumentError for empty title, actual %v", err)
+	}
+
+	var ids []string
+	for _, title := range []string{"login", "logout", "signup"} {
+		s, err := pi.AddStory(ctx, roomID, "alice", &porker.Story{Title: title})
+		if err != nil {
+			t.Fatalf("failed to AddStory: %v", err)
+		}
+		ids = append(ids, s.StoryId)
+	}
+	if err := pi.MoveStory(ctx, roomID, "alice", ids[2], 1); err != nil {
+		t.Fatalf("failed to MoveStory: %v", err)
+	}
+	if err := pi.RemoveStory(ctx, roomID, "bob", ids[1]); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for non master, actual %v", err)
+	}
+
+	// everyone votes 5 for "login", so 5 is recorded and "signup" comes next
+	if err := pi.Voting(ctx, roomID, "alice", porker.Point_POINT_5, ""); err != nil {
+		t.Fatalf("failed to Voting: %v", err)
+	}
+	if err := pi.Voting(ctx, roomID, "bob", porker.Point_NOT_VOTE, ""); err != nil {
+		t.Fatalf("failed to Voting: %v", err)
+	}
+	if err := pi.Reset(ctx, roomID, "alice", ""); err != nil {
+		t.Fatalf("failed to Reset: %v", err)
+	}
+	if err := pi.Reset(ctx, roomID, "alice", "XL"); !errs.IsInvalidArgumentError(err) {
+		t.Errorf("expected InvalidArgumentError for estimate out of the deck, actual %v", err)
+	}
+	if err := pi.Reset(ctx, roomID, "alice", "8"); err != nil {
+		t.Fatalf("failed to Reset: %v", err)
+	}
+
+	_, ps, err := rFactory.PokerRepository().ReadStreamLatest(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	var actual []string
+	for _, s := range ps.Stories {
+		actual = append(actual, s.Title+":"+s.Estimate)
+	}
+	if expected := "login:5 signup:8 logout:"; strings.Join(actual, " ") != expected {
+		t.Errorf("expected %s, actual %s", expected, strings.Join(actual, " "))
+	}
+	if ps.CurrentStoryId != ids[1] {
+		t.Errorf("expected current story %s, actual %s", ids[1], ps.CurrentStoryId)
+	}
+
+	rounds, err := rFactory.ArchiveRepository().ListByRoom(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ListByRoom: %v", err)
+	}
+	if len(rounds) != 1 || rounds[0].Story != "login" {
+		t.Errorf("expected the round of the story login, actual %v", rounds)
+	}
+}
+
+func TestPokerInteractor_ResetMostVoted(t *testing.T) {
+	ctx := context.Background()
+	rFactory := repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false))
+	m := newMetrics(t)
+	m.EXPECT().Voted().Times(6)
+	m.EXPECT().Revealed().Times(2)
+	m.EXPECT().Reset().Times(2)
+	pi := NewPokerInteractor(rFactory, m)
+
+	roomID, err := pi.Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	for _, loginID := range []string{"alice", "bob", "carol"} {
+		if _, err := pi.Enter(ctx, roomID, loginID, porker.Role_ROLE_UNKNOWN); err != nil {
+			t.Fatalf("failed to Enter: %v", err)
+		}
+	}
+	if _, err := pi.AddStory(ctx, roomID, "alice", &porker.Story{Title: "login"}); err != nil {
+		t.Fatalf("failed to AddStory: %v", err)
+	}
+
+	voteAndReset := func(points ...porker.Point) *porker.PokerSituation {
+		for i, loginID := range []string{"alice", "bob", "carol"} {
+			if err := pi.Voting(ctx, roomID, loginID, points[i], ""); err != nil {
+				t.Fatalf("failed to Voting: %v", err)
+			}
+		}
+		if err := pi.Reset(ctx, roomID, "alice", ""); err != nil {
+			t.Fatalf("failed to Reset: %v", err)
+		}
+		_, ps, err := rFactory.PokerRepository().ReadStreamLatest(ctx, roomID)
+		if err != nil {
+			t.Fatalf("failed to ReadStreamLatest: %v", err)
+		}
+		return ps
+	}
+
+	// every card is voted the most, so the story is voted again
+	ps := voteAndReset(porker.Point_POINT_3, porker.Point_POINT_5, porker.Point_POINT_8)
+	if ps.Stories[0].Estimate != "" || ps.CurrentStoryId != ps.Stories[0].StoryId {
+		t.Errorf("expected the story to stay without an estimate, actual %v", ps.Stories)
+	}
+
+	ps = voteAndReset(porker.Point_POINT_5, porker.Point_POINT_5, porker.Point_POINT_8)
+	if ps.Stories[0].Estimate != "5" || ps.CurrentStoryId != "" {
+		t.Errorf("expected the most voted card to be recorded, actual %v", ps.Stories)
+	}
+
+	if err := pi.Reset(ctx, roomID, "alice", "3"); !errs.IsInvalidStateError(err) {
+		t.Errorf("expected InvalidStateError for an estimate without the current story, actual %v", err)
+	}
+}
+
+func TestPokerInteractor_StartTimer(t *testing.T) {
+	ctx := context.Background()
+	mFactory := newMemoryFactory(t)
+	rFactory := repositories.NewFactory(mFactory, repositories.SituationFormatJSON, room.NewKeyStrategy(false))
+	// instances sharing the same mem db
+	instances := make([]*pokerInteractor, 2)
+	clocks := make([]*fakeClock, 2)
+	for i := range instances {
+		m := newMetrics(t)
+		m.EXPECT().Voted().AnyTimes()
+		m.EXPECT().Revealed().AnyTimes()
+		clocks[i] = newFakeClock()
+		instances[i] = newPokerInteractor(repositories.NewFactory(mFactory, repositories.SituationFormatJSON, room.NewKeyStrategy(false)), m, clocks[i])
+		// the timers must stop before the archive is closed
+		t.Cleanup(instances[i].Close)
+	}
+
+	roomID, err := instances[0].Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	enterCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// the members are connected to the other instance than the one starting the timer
+	for _, loginID := range []string{"alice", "bob"} {
+		if _, err := instances[1].Enter(enterCtx, roomID, loginID, porker.Role_ROLE_UNKNOWN); err != nil {
+			t.Fatalf("failed to Enter: %v", err)
+		}
+	}
+
+	if err := instances[0].StartTimer(ctx, roomID, "bob", time.Minute); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for non master, actual %v", err)
+	}
+	for _, d := range []time.Duration{0, MaxTimerDuration + time.Second} {
+		if err := instances[0].StartTimer(ctx, roomID, "alice", d); !errs.IsInvalidArgumentError(err) {
+			t.Errorf("expected InvalidArgumentError for %s, actual %v", d, err)
+		}
+	}
+
+	if err := instances[0].Voting(ctx, roomID, "alice", porker.Point_POINT_3, ""); err != nil {
+		t.Fatalf("failed to Voting: %v", err)
+	}
+	if err := instances[0].StartTimer(ctx, roomID, "alice", time.Minute); err != nil {
+		t.Fatalf("failed to StartTimer: %v", err)
+	}
+
+	_, ps, err := rFactory.PokerRepository().ReadStreamLatest(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if ps.Deadline == nil || ps.State != porker.RoomState_ROOM_STATE_TURN_DOWN {
+		t.Fatalf("expected the deadline in TURN_DOWN, actual %v", ps)
+	}
+
+	// the instance starting the timer goes away, and the other instance reveals the room without the vote of bob
+	clocks[0].next(t)
+	instances[0].Close()
+	clocks[1].next(t).fire()
+
+	if _, ps, err = rFactory.PokerRepository().ReadStreamLatest(ctx, roomID); err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if ps.State != porker.RoomState_ROOM_STATE_OPEN || ps.Deadline != nil {
+		t.Errorf("expected the revealed room without deadline, actual %v", ps)
+	}
+	rounds, err := rFactory.ArchiveRepository().ListByRoom(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ListByRoom: %v", err)
+	}
+	if len(rounds) != 1 || len(rounds[0].Ballots) != 2 || rounds[0].RevealedAt.IsZero() {
+		t.Errorf("expected the archived round with both ballots, actual %v", rounds)
+	}
+}
+
+func TestPokerInteractor_Roles(t *testing.T) {
+	ctx := context.Background()
+	rFactory := repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false))
+	m := newMetrics(t)
+	m.EXPECT().Voted().Times(2)
+	m.EXPECT().Revealed()
+	pi := NewPokerInteractor(rFactory, m)
+
+	roomID, err := pi.Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	if _, err := pi.Enter(ctx, roomID, "bob", porker.Role_ROLE_FACILITATOR); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for entering as facilitator, actual %v", err)
+	}
+	for loginID, role := range map[string]porker.Role{
+		"alice": porker.Role_ROLE_UNKNOWN,
+		"bob":   porker.Role_ROLE_VOTER,
+		"carol": porker.Role_ROLE_VOTER,
+	} {
+		if _, err := pi.Enter(ctx, roomID, loginID, role); err != nil {
+			t.Fatalf("failed to Enter: %v", err)
+		}
+	}
+
+	if err := pi.ChangeRole(ctx, roomID, "bob", "carol", porker.Role_ROLE_OBSERVER); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for voter, actual %v", err)
+	}
+	if err := pi.ChangeRole(ctx, roomID, "alice", "bob", porker.Role_ROLE_FACILITATOR); err != nil {
+		t.Fatalf("failed to ChangeRole: %v", err)
+	}
+	// promoted facilitators can manage the room
+	if err := pi.ChangeRole(ctx, roomID, "bob", "carol", porker.Role_ROLE_OBSERVER); err != nil {
+		t.Fatalf("failed to ChangeRole: %v", err)
+	}
+	// the demoted member enters again, but stays an observer
+	if _, err := pi.Enter(ctx, roomID, "carol", porker.Role_ROLE_VOTER); err != nil {
+		t.Fatalf("failed to Enter: %v", err)
+	}
+
+	if err := pi.Voting(ctx, roomID, "carol", porker.Point_POINT_3, ""); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for observer, actual %v", err)
+	}
+	// observers do not block the reveal
+	for _, loginID := range []string{"alice", "bob"} {
+		if err := pi.Voting(ctx, roomID, loginID, porker.Point_POINT_3, ""); err != nil {
+			t.Fatalf("failed to Voting: %v", err)
+		}
+	}
+
+	_, ps, err := rFactory.PokerRepository().ReadStreamLatest(ctx, roomID)
+	if err != nil {
+		t.Fatalf("failed to ReadStreamLatest: %v", err)
+	}
+	if ps.State != porker.RoomState_ROOM_STATE_OPEN {
+		t.Errorf("expected %s, actual %s", porker.RoomState_ROOM_STATE_OPEN, ps.State)
+	}
+	roles := map[string]porker.Role{}
+	for _, mb := range ps.Members {
+		roles[mb.LoginId] = mb.Role
+	}
+	expected := map[string]porker.Role{
+		"alice": porker.Role_ROLE_FACILITATOR,
+		"bob":   porker.Role_ROLE_FACILITATOR,
+		"carol": porker.Role_ROLE_OBSERVER,
+	}
+	if !reflect.DeepEqual(roles, expected) {
+		t.Errorf("expected %v, actual %v", expected, roles)
+	}
+}
+
+func TestPokerInteractor_ResetPermission(t *testing.T) {
+	ctx := context.Background()
+	m := newMetrics(t)
+	m.EXPECT().Reset().Times(2)
+	pi := NewPokerInteractor(repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false)), m)
+
+	roomID, err := pi.Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	for _, loginID := range []string{"alice", "bob"} {
+		if _, err := pi.Enter(ctx, roomID, loginID, porker.Role_ROLE_UNKNOWN); err != nil {
+			t.Fatalf("failed to Enter: %v", err)
+		}
+	}
+
+	if err := pi.Reset(ctx, roomID, "mallory", ""); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for non member, actual %v", err)
+	}
+	// voters can start a new round of the room without stories
+	if err := pi.Reset(ctx, roomID, "bob", ""); err != nil {
+		t.Fatalf("failed to Reset: %v", err)
+	}
+	if err := pi.Reset(ctx, roomID, "bob", "5"); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for estimate by voter, actual %v", err)
+	}
+
+	if _, err := pi.AddStory(ctx, roomID, "alice", &porker.Story{Title: "login"}); err != nil {
+		t.Fatalf("failed to AddStory: %v", err)
+	}
+	if err := pi.Reset(ctx, roomID, "bob", ""); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for voter with the current story, actual %v", err)
+	}
+	if err := pi.Reset(ctx, roomID, "alice", ""); err != nil {
+		t.Fatalf("failed to Reset: %v", err)
+	}
+}
+
+func TestPokerInteractor_ExpiredRoom(t *testing.T) {
+	ctx := context.Background()
+	mFactory := newMemoryFactory(t)
+	pi := NewPokerInteractor(repositories.NewFactory(mFactory, repositories.SituationFormatJSON, room.NewKeyStrategy(false)), newMetrics(t))
+
+	roomID, err := pi.Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	if _, err := pi.Enter(ctx, roomID, "alice", porker.Role_ROLE_UNKNOWN); err != nil {
+		t.Fatalf("failed to Enter: %v", err)
+	}
+	// the id key has timed out while the stream transport still keeps the room stream
+	if err := mFactory.memDBCli.Del(ctx, roomID.IDKey()); err != nil {
+		t.Fatalf("failed to Del: %v", err)
+	}
+
+	if ok, err := pi.CanEnter(ctx, roomID); err != nil || ok {
+		t.Errorf("expired room must not be entered, actual %v, err: %v", ok, err)
+	}
+	if _, err := pi.History(ctx, roomID, "alice"); !errs.IsNotFoundError(err) {
+		t.Errorf("expected NotFoundError for expired room, actual %v", err)
+	}
+}
+
+func TestPokerInteractor_ArchivedRounds(t *testing.T) {
+	ctx := context.Background()
+	m := newMetrics(t)
+	m.EXPECT().Voted()
+	m.EXPECT().Revealed()
+	rFactory := repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false))
+	pi := NewPokerInteractor(rFactory, m)
+
+	roomID, err := pi.Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	if _, err := pi.Enter(ctx, roomID, "alice", porker.Role_ROLE_UNKNOWN); err != nil {
+		t.Fatalf("failed to Enter: %v", err)
+	}
+	if err := pi.Voting(ctx, roomID, "alice", porker.Point_POINT_3, ""); err != nil {
+		t.Fatalf("failed to Voting: %v", err)
+	}
+
+	if _, err := pi.ArchivedRounds(ctx, roomID, "bob"); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for outsider, actual %v", err)
+	}
+	if _, err := pi.History(ctx, roomID, "bob"); !errs.IsPermissionDeniedError(err) {
+		t.Errorf("expected PermissionDeniedError for outsider, actual %v", err)
+	}
+
+	// 部屋がタイムアウトしても投票した人は見返せる
+	if err := rFactory.PokerRepository().Delete(ctx, roomID); err != nil {
+		t.Fatalf("failed to Delete: %v", err)
+	}
+	rounds, err := pi.ArchivedRounds(ctx, roomID, "alice")
+	if err != nil {
+		t.Fatalf("failed to ArchivedRounds: %v", err)
+	}
+	if len(rounds) != 1 || len(rounds[0].Ballots) != 1 || rounds[0].Ballots[0].Point != porker.Point_POINT_3 {
+		t.Fatalf("expected one round with the ballot of alice, actual %v", rounds)
+	}
+	if rounds[0].StartedAt == nil || rounds[0].StartedAt.AsTime().After(rounds[0].RevealedAt.AsTime()) {
+		t.Errorf("round must be started before revealed: %v - %v", rounds[0].StartedAt, rounds[0].RevealedAt)
+	}
+}
+
+func TestPokerInteractor_Tracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	original := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(original) })
+
+	ctx := context.Background()
+	m := newMetrics(t)
+	m.EXPECT().Voted()
+	m.EXPECT().Revealed()
+	pi := NewPokerInteractor(repositories.NewFactory(newMemoryFactory(t), repositories.SituationFormatJSON, room.NewKeyStrategy(false)), m)
+
+	roomID, err := pi.Create(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	if _, err := pi.Enter(ctx, roomID, "alice", porker.Role_ROLE_UNKNOWN); err != nil {
+		t.Fatalf("failed to Enter: %v", err)
+	}
+	if err := pi.Voting(ctx, roomID, "alice", porker.Point_POINT_1, ""); err != nil {
+		t.Fatalf("failed to Voting: %v", err)
+	}
+
+	var voting sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Name() == "PokerInteractor.Voting" {
+			voting = span
+		}
+	}
+	if voting == nil {
+		t.Fatal("PokerInteractor.Voting span was not recorded")
+	}
+	attrs := map[attribute.Key]string{}
+	for _, kv := range voting.Attributes() {
+		attrs[kv.Key] = kv.Value.Emit()
+	}
+	if attrs[tracers.RoomIDKey] != roomID.String() || attrs[tracers.LoginIDKey] != "alice" {
+		t.Errorf("expected room_id and login_id attributes, actual %v", attrs)
+	}
+
+	children := map[string]bool{}
+	for _, span := range recorder.Ended() {
+		if span.SpanContext().TraceID() == voting.SpanContext().TraceID() {
+			children[span.Name()] = true
+		}
+	}
+	for _, name := range []string{"PokerInteractor.updateSituation", "PokerRepository.CompareAndUpdate", "PokerRepository.refreshRoomDuration"} {
+		if !children[name] {
+			t.Errorf("expected %s span in the trace of Voting", name)
+		}
+	}
+}
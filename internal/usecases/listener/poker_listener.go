@@ -5,50 +5,65 @@ import (
 
 	"github.com/swallowarc/porker-proto/pkg/porker"
 	"github.com/swallowarc/porker-rpc/internal/domains/room"
-	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
 	"golang.org/x/xerrors"
+	"google.golang.org/protobuf/proto"
 )
 
 type (
+	// pokerListener receives situations of a room from the feed of the RoomHub.
 	pokerListener struct {
-		roomID        room.ID
-		loginID       string
-		pokerRepo     ports.PokerRepository
-		lastMessageID string
+		roomID  room.ID
+		loginID string
+		// observer follows the room without being a member of it.
+		observer bool
+		// seq is the order of subscription in the RoomHub.
+		seq  uint64
+		ch   chan *porker.PokerSituation
+		left chan struct{}
 	}
 )
 
 var LeftError = xerrors.New("already left the room")
 
-func NewPokerListener(roomID room.ID, loginID string, pokerRepo ports.PokerRepository) ports.PokerListener {
+func newPokerListener(roomID room.ID, loginID string, observer bool) *pokerListener {
 	return &pokerListener{
-		roomID:    roomID,
-		loginID:   loginID,
-		pokerRepo: pokerRepo,
+		roomID:   roomID,
+		loginID:  loginID,
+		observer: observer,
+		ch:       make(chan *porker.PokerSituation, 1),
+		left:     make(chan struct{}),
 	}
 }
 
 func (l *pokerListener) Listen(ctx context.Context) (*porker.PokerSituation, error) {
-	isExists, err := l.pokerRepo.IsExistsInRoom(ctx, l.roomID, l.loginID)
-	if err != nil {
-		return nil, xerrors.Errorf("failed to IsExistsInRoom: %w", err)
-	}
-	if !isExists {
+	select {
+	case ps := <-l.ch:
+		return ps, nil
+	case <-l.left:
 		return nil, LeftError
+	case <-ctx.Done():
+		return nil, xerrors.Errorf("failed to Listen: %w", ctx.Err())
 	}
+}
 
-	var ps *porker.PokerSituation
-	if l.lastMessageID == "" {
-		l.lastMessageID, ps, err = l.pokerRepo.ReadStreamLatest(ctx, l.roomID)
-		if err != nil {
-			return nil, xerrors.Errorf("failed to ReadStreamLatest: %w", err)
-		}
-	} else {
-		l.lastMessageID, ps, err = l.pokerRepo.ReadStream(ctx, l.roomID, l.lastMessageID)
-		if err != nil {
-			return nil, xerrors.Errorf("failed to ReadStream: %w", err)
+// deliver passes a copy of the situation to the listener, so that the listener can not change
+// the situation shared with the other listeners. Only the latest one is kept if the listener is slow.
+// It must be called from the RoomHub while holding its lock, so that the situations are not put concurrently.
+func (l *pokerListener) deliver(ps *porker.PokerSituation) {
+	ps = proto.Clone(ps).(*porker.PokerSituation)
+	select {
+	case l.ch <- ps:
+	default:
+		select {
+		case <-l.ch:
+		default:
 		}
+		l.ch <- ps
 	}
+}
 
-	return ps, nil
+// leave notifies the listener that the login has left the room.
+// It must be called only once, from the RoomHub while holding its lock.
+func (l *pokerListener) leave() {
+	close(l.left)
 }
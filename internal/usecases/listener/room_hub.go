@@ -0,0 +1,234 @@
+package listener
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/commons/errs"
+	"github.com/swallowarc/porker-rpc/internal/commons/loggers"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
+	"go.uber.org/zap"
+)
+
+const (
+	retryInterval = time.Second
+)
+
+type (
+	// RoomHub holds one stream reader per active room in this process
+	// and fans the situations out to all listeners of the room.
+	RoomHub interface {
+		// Subscribe returns a listener of the room, which is removed from the hub when ctx is done.
+		Subscribe(ctx context.Context, roomID room.ID, loginID string) ports.PokerListener
+		// Watch returns a listener of the room that is not a member of it.
+		// The listener leaves when nobody is in the room anymore.
+		Watch(ctx context.Context, roomID room.ID) ports.PokerListener
+	}
+
+	// SituationHandler is called with every situation the hub reads, on any instance listening to the room.
+	SituationHandler func(ctx context.Context, ps *porker.PokerSituation)
+
+	roomHub struct {
+		pokerRepo   ports.PokerRepository
+		metrics     ports.PokerMetrics
+		onSituation SituationHandler
+
+		mu    sync.Mutex
+		seq   uint64
+		feeds map[room.ID]*roomFeed
+	}
+
+	roomFeed struct {
+		roomID    room.ID
+		cancel    context.CancelFunc
+		listeners map[*pokerListener]struct{}
+		latest    *porker.PokerSituation
+	}
+)
+
+// NewRoomHub returns a hub. onSituation can be nil.
+func NewRoomHub(pokerRepo ports.PokerRepository, pokerMetrics ports.PokerMetrics, onSituation SituationHandler) RoomHub {
+	return &roomHub{
+		pokerRepo:   pokerRepo,
+		metrics:     pokerMetrics,
+		onSituation: onSituation,
+		feeds:       map[room.ID]*roomFeed{},
+	}
+}
+
+func (h *roomHub) Subscribe(ctx context.Context, roomID room.ID, loginID string) ports.PokerListener {
+	return h.subscribe(ctx, newPokerListener(roomID, loginID, false))
+}
+
+func (h *roomHub) Watch(ctx context.Context, roomID room.ID) ports.PokerListener {
+	return h.subscribe(ctx, newPokerListener(roomID, "", true))
+}
+
+func (h *roomHub) subscribe(ctx context.Context, l *pokerListener) ports.PokerListener {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seq++
+	l.seq = h.seq
+	roomID := l.roomID
+
+	f, ok := h.feeds[roomID]
+	if !ok {
+		feedCtx, cancel := context.WithCancel(loggers.LoggerToContext(context.Background(), loggers.Logger(ctx)))
+		f = &roomFeed{
+			roomID:    roomID,
+			cancel:    cancel,
+			listeners: map[*pokerListener]struct{}{},
+		}
+		h.feeds[roomID] = f
+		h.metrics.RoomActivated()
+		go h.run(feedCtx, f)
+	}
+
+	f.listeners[l] = struct{}{}
+	if l.observer {
+		h.metrics.WatcherAdded()
+	} else {
+		h.metrics.ListenerAdded()
+	}
+	if f.latest != nil {
+		l.deliver(f.latest)
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			h.unsubscribe(f, l)
+		case <-l.left:
+		}
+	}()
+
+	return l
+}
+
+func (h *roomHub) unsubscribe(f *roomFeed, l *pokerListener) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := f.listeners[l]; ok {
+		h.remove(f, l)
+	}
+}
+
+// remove deletes the listener from the feed and stops the feed when nobody listens to it anymore.
+// It must be called while holding the lock.
+func (h *roomHub) remove(f *roomFeed, l *pokerListener) {
+	delete(f.listeners, l)
+	if l.observer {
+		h.metrics.WatcherRemoved()
+	} else {
+		h.metrics.ListenerRemoved()
+	}
+	if len(f.listeners) > 0 {
+		return
+	}
+
+	f.cancel()
+	if h.feeds[f.roomID] == f {
+		delete(h.feeds, f.roomID)
+		h.metrics.RoomDeactivated()
+	}
+}
+
+// run reads the room stream and broadcasts it until the feed is canceled.
+func (h *roomHub) run(ctx context.Context, f *roomFeed) {
+	var lastID string
+	for ctx.Err() == nil {
+		var (
+			msgID string
+			ps    *porker.PokerSituation
+			err   error
+		)
+		if lastID == "" {
+			msgID, ps, err = h.pokerRepo.ReadStreamLatest(ctx, f.roomID)
+		} else {
+			msgID, ps, err = h.pokerRepo.ReadStream(ctx, f.roomID, lastID)
+		}
+
+		switch {
+		case err == nil:
+			lastID = msgID
+		case errs.IsNotFoundError(err):
+			// 更新がなくタイムアウトした場合も退室者の確認はする
+		case ctx.Err() != nil:
+			return
+		default:
+			loggers.Logger(ctx).Warn("failed to read room stream", zap.String("room_id", f.roomID.String()), zap.Error(err))
+			sleep(ctx, retryInterval)
+			continue
+		}
+
+		// ListMembers より後に購読した listener は退室判定の対象外にする
+		h.mu.Lock()
+		seq := h.seq
+		h.mu.Unlock()
+
+		members, err := h.pokerRepo.ListMembers(ctx, f.roomID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			loggers.Logger(ctx).Warn("failed to list room members", zap.String("room_id", f.roomID.String()), zap.Error(err))
+			sleep(ctx, retryInterval)
+			continue
+		}
+
+		h.broadcast(f, members, seq, ps)
+		if ps != nil && h.onSituation != nil {
+			h.onSituation(ctx, ps)
+		}
+
+		if lastID == "" {
+			// ReadStreamLatest does not block, so wait for the room to be created.
+			sleep(ctx, retryInterval)
+		}
+	}
+}
+
+// broadcast delivers ps to the listeners and notifies listeners that are no longer members of the room,
+// and observers of the room that nobody is in.
+func (h *roomHub) broadcast(f *roomFeed, members []string, seq uint64, ps *porker.PokerSituation) {
+	isMember := make(map[string]bool, len(members))
+	for _, m := range members {
+		isMember[m] = true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for l := range f.listeners {
+		left := !isMember[l.loginID]
+		if l.observer {
+			left = len(members) == 0
+		}
+		if l.seq <= seq && left {
+			h.remove(f, l)
+			l.leave()
+			continue
+		}
+		if ps != nil {
+			l.deliver(ps)
+		}
+	}
+	if ps != nil {
+		f.latest = ps
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
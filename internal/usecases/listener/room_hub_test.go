@@ -0,0 +1,238 @@
+package listener
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/swallowarc/porker-proto/pkg/porker"
+	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/infrastructures/memory"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/gateways"
+	"github.com/swallowarc/porker-rpc/internal/interface_adapters/repositories"
+	mock_ports "github.com/swallowarc/porker-rpc/internal/tests/mocks/ports"
+	"github.com/swallowarc/porker-rpc/internal/usecases/ports"
+	"golang.org/x/xerrors"
+)
+
+type (
+	memoryFactory struct {
+		memDBCli gateways.MemDBClient
+	}
+)
+
+func (f memoryFactory) MemDBClient() gateways.MemDBClient {
+	return f.memDBCli
+}
+
+func (f memoryFactory) StreamClient() gateways.StreamClient {
+	return f.memDBCli
+}
+
+func (f memoryFactory) RDBClient() gateways.RDBClient {
+	return nil
+}
+
+func (f memoryFactory) Close() error {
+	return nil
+}
+
+func setupRoom(t *testing.T, ctx context.Context, loginIDs ...string) (ports.PokerRepository, room.ID) {
+	pokerRepo := repositories.NewPokerRepository(memoryFactory{memDBCli: memory.NewMemoryClient(gateways.StreamRetention{MaxLen: 1})}, repositories.SituationFormatJSON, room.NewKeyStrategy(false))
+
+	roomID, err := pokerRepo.Create(ctx, loginIDs[0], nil)
+	if err != nil {
+		t.Fatalf("failed to Create: %v", err)
+	}
+	for _, id := range loginIDs {
+		if err := pokerRepo.Enter(ctx, roomID, id); err != nil {
+			t.Fatalf("failed to Enter: %v", err)
+		}
+	}
+	return pokerRepo, roomID
+}
+
+// anyMetrics accepts any metrics records.
+func anyMetrics(t *testing.T) ports.PokerMetrics {
+	m := mock_ports.NewMockPokerMetrics(gomock.NewController(t))
+	m.EXPECT().RoomActivated().AnyTimes()
+	m.EXPECT().RoomDeactivated().AnyTimes()
+	m.EXPECT().ListenerAdded().AnyTimes()
+	m.EXPECT().ListenerRemoved().AnyTimes()
+	m.EXPECT().WatcherAdded().AnyTimes()
+	m.EXPECT().WatcherRemoved().AnyTimes()
+	return m
+}
+
+func listen(t *testing.T, l ports.PokerListener) (*porker.PokerSituation, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return l.Listen(ctx)
+}
+
+func TestRoomHub_Broadcast(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pokerRepo, roomID := setupRoom(t, ctx, "a", "b")
+	hub := NewRoomHub(pokerRepo, anyMetrics(t), nil).(*roomHub)
+
+	la := hub.Subscribe(ctx, roomID, "a")
+	lb := hub.Subscribe(ctx, roomID, "b")
+	for _, l := range []ports.PokerListener{la, lb} {
+		if _, err := listen(t, l); err != nil {
+			t.Fatalf("failed to receive the initial situation: %v", err)
+		}
+	}
+
+	if err := pokerRepo.Update(ctx, &porker.PokerSituation{
+		RoomId: roomID.String(),
+		State:  porker.RoomState_ROOM_STATE_OPEN,
+	}); err != nil {
+		t.Fatalf("failed to Update: %v", err)
+	}
+
+	received := make([]*porker.PokerSituation, 0, 2)
+	for _, l := range []ports.PokerListener{la, lb} {
+		ps, err := listen(t, l)
+		if err != nil {
+			t.Fatalf("failed to Listen: %v", err)
+		}
+		if ps.State != porker.RoomState_ROOM_STATE_OPEN {
+			t.Errorf("expected %s, actual %s", porker.RoomState_ROOM_STATE_OPEN, ps.State)
+		}
+		received = append(received, ps)
+	}
+	// 受信した situation を書き換えても他の listener に影響しない
+	received[0].State = porker.RoomState_ROOM_STATE_TURN_DOWN
+	if received[1].State != porker.RoomState_ROOM_STATE_OPEN {
+		t.Errorf("listeners must receive copies of the situation")
+	}
+
+	hub.mu.Lock()
+	feeds := len(hub.feeds)
+	hub.mu.Unlock()
+	if feeds != 1 {
+		t.Errorf("expected one feed for the room, actual %d", feeds)
+	}
+}
+
+func TestRoomHub_Left(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pokerRepo, roomID := setupRoom(t, ctx, "a", "b")
+	hub := NewRoomHub(pokerRepo, anyMetrics(t), nil)
+
+	l := hub.Subscribe(ctx, roomID, "b")
+	if err := pokerRepo.Leave(ctx, roomID, "b"); err != nil {
+		t.Fatalf("failed to Leave: %v", err)
+	}
+
+	for {
+		_, err := listen(t, l)
+		if xerrors.Is(err, LeftError) {
+			return
+		}
+		if err != nil {
+			t.Fatalf("expected LeftError, actual %v", err)
+		}
+	}
+}
+
+func TestRoomHub_Unsubscribe(t *testing.T) {
+	ctx := context.Background()
+	pokerRepo, roomID := setupRoom(t, ctx, "a")
+	m := mock_ports.NewMockPokerMetrics(gomock.NewController(t))
+	gomock.InOrder(
+		m.EXPECT().RoomActivated(),
+		m.EXPECT().ListenerAdded(),
+		m.EXPECT().ListenerRemoved(),
+		m.EXPECT().RoomDeactivated(),
+	)
+	hub := NewRoomHub(pokerRepo, m, nil).(*roomHub)
+
+	subCtx, cancel := context.WithCancel(ctx)
+	hub.Subscribe(subCtx, roomID, "a")
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		hub.mu.Lock()
+		feeds := len(hub.feeds)
+		hub.mu.Unlock()
+		if feeds == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Error("feed was not stopped after the last listener was gone")
+}
+
+func TestRoomHub_UnsubscribeWatcher(t *testing.T) {
+	ctx := context.Background()
+	pokerRepo, roomID := setupRoom(t, ctx, "a")
+	m := mock_ports.NewMockPokerMetrics(gomock.NewController(t))
+	gomock.InOrder(
+		m.EXPECT().RoomActivated(),
+		m.EXPECT().WatcherAdded(),
+		m.EXPECT().WatcherRemoved(),
+		m.EXPECT().RoomDeactivated(),
+	)
+	hub := NewRoomHub(pokerRepo, m, nil).(*roomHub)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	hub.Watch(watchCtx, roomID)
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		hub.mu.Lock()
+		feeds := len(hub.feeds)
+		hub.mu.Unlock()
+		if feeds == 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Error("feed was not stopped after the watcher was gone")
+}
+
+func TestRoomHub_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pokerRepo, roomID := setupRoom(t, ctx, "a")
+	hub := NewRoomHub(pokerRepo, anyMetrics(t), nil)
+
+	l := hub.Watch(ctx, roomID)
+	if _, err := listen(t, l); err != nil {
+		t.Fatalf("failed to receive the initial situation: %v", err)
+	}
+
+	if err := pokerRepo.Update(ctx, &porker.PokerSituation{
+		RoomId: roomID.String(),
+		State:  porker.RoomState_ROOM_STATE_OPEN,
+	}); err != nil {
+		t.Fatalf("failed to Update: %v", err)
+	}
+	ps, err := listen(t, l)
+	if err != nil {
+		t.Fatalf("failed to Listen: %v", err)
+	}
+	if ps.State != porker.RoomState_ROOM_STATE_OPEN {
+		t.Errorf("expected %s, actual %s", porker.RoomState_ROOM_STATE_OPEN, ps.State)
+	}
+
+	// the observer is not a member but stays until the last member leaves
+	if err := pokerRepo.Leave(ctx, roomID, "a"); err != nil {
+		t.Fatalf("failed to Leave: %v", err)
+	}
+	for {
+		_, err := listen(t, l)
+		if xerrors.Is(err, LeftError) {
+			return
+		}
+		if err != nil {
+			t.Fatalf("expected LeftError, actual %v", err)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+//go:generate mockgen -source=$GOFILE -destination=../../tests/mocks/$GOPACKAGE/mock_$GOFILE -package=mock_$GOPACKAGE
+package ports
+
+type (
+	// PokerMetrics records the activity of rooms in this process.
+	PokerMetrics interface {
+		// RoomActivated and RoomDeactivated are called when a room starts and stops being listened to.
+		RoomActivated()
+		RoomDeactivated()
+		// ListenerAdded and ListenerRemoved are called when an EnterRoom stream starts and ends.
+		ListenerAdded()
+		ListenerRemoved()
+		// WatcherAdded and WatcherRemoved are called when a room feed watching a room without membership starts and ends.
+		WatcherAdded()
+		WatcherRemoved()
+		Voted()
+		Revealed()
+		Reset()
+	}
+)
@@ -4,5 +4,6 @@ type (
 	RepositoriesFactory interface {
 		LoginRepository() LoginRepository
 		PokerRepository() PokerRepository
+		ArchiveRepository() ArchiveRepository
 	}
 )
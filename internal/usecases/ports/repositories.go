@@ -6,25 +6,44 @@ import (
 
 	"github.com/swallowarc/porker-proto/pkg/porker"
 	"github.com/swallowarc/porker-rpc/internal/domains/room"
+	"github.com/swallowarc/porker-rpc/internal/domains/round"
 )
 
 type (
 	LoginRepository interface {
 		FindByID(ctx context.Context, loginID string) (*porker.Login, error)
 		NewLogin(ctx context.Context, loginID string) (*porker.Login, error)
-		ReLogin(ctx context.Context, login *porker.Login) error
+		// Renew replaces the session of the login with a new one.
+		Renew(ctx context.Context, loginID string) (*porker.Login, error)
+		// Touch marks the session as in use and extends the login.
+		Touch(ctx context.Context, login *porker.Login) error
+		// IsActive reports whether the session of the login has been used recently.
+		IsActive(ctx context.Context, loginID string) (bool, error)
+		// NewFeedTicket issues a short-lived ticket for the room feed of the room.
+		NewFeedTicket(ctx context.Context, roomID room.ID, login *porker.Login) (string, error)
+		// UseFeedTicket returns the login of the ticket, which can be used only once.
+		UseFeedTicket(ctx context.Context, roomID room.ID, ticket string) (*porker.Login, error)
 		Logout(ctx context.Context, loginID string) error
 	}
 
 	PokerRepository interface {
-		Create(ctx context.Context, loginID string) (room.ID, error)
+		Create(ctx context.Context, loginID string, deck *porker.Deck) (room.ID, error)
 		Update(ctx context.Context, ps *porker.PokerSituation) error
+		CompareAndUpdate(ctx context.Context, messageID string, ps *porker.PokerSituation) error
 		Enter(ctx context.Context, roomID room.ID, loginID string) error
 		Leave(ctx context.Context, roomID room.ID, loginID string) error
 		ReadStreamLatest(ctx context.Context, roomID room.ID) (string, *porker.PokerSituation, error)
 		ReadStream(ctx context.Context, roomID room.ID, messageID string) (string, *porker.PokerSituation, error)
+		ListHistory(ctx context.Context, roomID room.ID) ([]*porker.RoomSnapshot, error)
 		ListMembers(ctx context.Context, roomID room.ID) ([]string, error)
 		IsExistsInRoom(ctx context.Context, roomID room.ID, loginID string) (bool, error)
 		Delete(ctx context.Context, roomID room.ID) error
 	}
+
+	// ArchiveRepository stores revealed rounds durably for looking back on past sessions.
+	ArchiveRepository interface {
+		Save(ctx context.Context, rd *round.Round) error
+		// ListByRoom returns errs.InvalidStateError if the archive is disabled.
+		ListByRoom(ctx context.Context, roomID room.ID) ([]*round.Round, error)
+	}
 )
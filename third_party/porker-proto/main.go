@@ -0,0 +1,5 @@
+package main
+
+func main() {
+	print("This code is needed to import.")
+}
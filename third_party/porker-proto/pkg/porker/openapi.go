@@ -0,0 +1,9 @@
+package porker
+
+import (
+	_ "embed"
+)
+
+// OpenAPI is the OpenAPI v2 document of the HTTP/JSON mapping of PorkerService, generated into api.swagger.json.
+//go:embed api.swagger.json
+var OpenAPI []byte
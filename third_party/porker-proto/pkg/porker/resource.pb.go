@@ -0,0 +1,1545 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.15.8
+// source: porker/resource.proto
+
+package porker
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Violations int32
+
+const (
+	Violations_UNDEFINED_VIOLATION Violations = 0
+)
+
+// Enum value maps for Violations.
+var (
+	Violations_name = map[int32]string{
+		0: "UNDEFINED_VIOLATION",
+	}
+	Violations_value = map[string]int32{
+		"UNDEFINED_VIOLATION": 0,
+	}
+)
+
+func (x Violations) Enum() *Violations {
+	p := new(Violations)
+	*p = x
+	return p
+}
+
+func (x Violations) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Violations) Descriptor() protoreflect.EnumDescriptor {
+	return file_porker_resource_proto_enumTypes[0].Descriptor()
+}
+
+func (Violations) Type() protoreflect.EnumType {
+	return &file_porker_resource_proto_enumTypes[0]
+}
+
+func (x Violations) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Violations.Descriptor instead.
+func (Violations) EnumDescriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{0}
+}
+
+type RoomState int32
+
+const (
+	RoomState_ROOM_STATE_UNKNOWN   RoomState = 0
+	RoomState_ROOM_STATE_TURN_DOWN RoomState = 1
+	RoomState_ROOM_STATE_OPEN      RoomState = 2
+)
+
+// Enum value maps for RoomState.
+var (
+	RoomState_name = map[int32]string{
+		0: "ROOM_STATE_UNKNOWN",
+		1: "ROOM_STATE_TURN_DOWN",
+		2: "ROOM_STATE_OPEN",
+	}
+	RoomState_value = map[string]int32{
+		"ROOM_STATE_UNKNOWN":   0,
+		"ROOM_STATE_TURN_DOWN": 1,
+		"ROOM_STATE_OPEN":      2,
+	}
+)
+
+func (x RoomState) Enum() *RoomState {
+	p := new(RoomState)
+	*p = x
+	return p
+}
+
+func (x RoomState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomState) Descriptor() protoreflect.EnumDescriptor {
+	return file_porker_resource_proto_enumTypes[1].Descriptor()
+}
+
+func (RoomState) Type() protoreflect.EnumType {
+	return &file_porker_resource_proto_enumTypes[1]
+}
+
+func (x RoomState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomState.Descriptor instead.
+func (RoomState) EnumDescriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{1}
+}
+
+type Point int32
+
+const (
+	Point_POINT_UNKNOWN  Point = 0
+	Point_POINT_0        Point = 1
+	Point_POINT_HALF     Point = 2
+	Point_POINT_1        Point = 3
+	Point_POINT_2        Point = 4
+	Point_POINT_3        Point = 5
+	Point_POINT_5        Point = 6
+	Point_POINT_8        Point = 7
+	Point_POINT_13       Point = 8
+	Point_POINT_21       Point = 9
+	Point_POINT_COFFEE   Point = 20
+	Point_POINT_QUESTION Point = 21
+	// POINT_CARD means the ballot holds a card of the deck that has no point above. See Ballot.card.
+	Point_POINT_CARD Point = 98
+	Point_NOT_VOTE   Point = 99
+)
+
+// Enum value maps for Point.
+var (
+	Point_name = map[int32]string{
+		0:  "POINT_UNKNOWN",
+		1:  "POINT_0",
+		2:  "POINT_HALF",
+		3:  "POINT_1",
+		4:  "POINT_2",
+		5:  "POINT_3",
+		6:  "POINT_5",
+		7:  "POINT_8",
+		8:  "POINT_13",
+		9:  "POINT_21",
+		20: "POINT_COFFEE",
+		21: "POINT_QUESTION",
+		98: "POINT_CARD",
+		99: "NOT_VOTE",
+	}
+	Point_value = map[string]int32{
+		"POINT_UNKNOWN":  0,
+		"POINT_0":        1,
+		"POINT_HALF":     2,
+		"POINT_1":        3,
+		"POINT_2":        4,
+		"POINT_3":        5,
+		"POINT_5":        6,
+		"POINT_8":        7,
+		"POINT_13":       8,
+		"POINT_21":       9,
+		"POINT_COFFEE":   20,
+		"POINT_QUESTION": 21,
+		"POINT_CARD":     98,
+		"NOT_VOTE":       99,
+	}
+)
+
+func (x Point) Enum() *Point {
+	p := new(Point)
+	*p = x
+	return p
+}
+
+func (x Point) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Point) Descriptor() protoreflect.EnumDescriptor {
+	return file_porker_resource_proto_enumTypes[2].Descriptor()
+}
+
+func (Point) Type() protoreflect.EnumType {
+	return &file_porker_resource_proto_enumTypes[2]
+}
+
+func (x Point) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Point.Descriptor instead.
+func (Point) EnumDescriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{2}
+}
+
+type Role int32
+
+const (
+	// ROLE_UNKNOWN is treated as ROLE_FACILITATOR for the master of the room and ROLE_VOTER for others when entering,
+	// and keeps the role when entering again.
+	Role_ROLE_UNKNOWN Role = 0
+	// ROLE_FACILITATOR votes and manages the room: stories, timer and roles.
+	Role_ROLE_FACILITATOR Role = 1
+	Role_ROLE_VOTER       Role = 2
+	// ROLE_OBSERVER receives the situations but has no ballot.
+	Role_ROLE_OBSERVER Role = 3
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNKNOWN",
+		1: "ROLE_FACILITATOR",
+		2: "ROLE_VOTER",
+		3: "ROLE_OBSERVER",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNKNOWN":     0,
+		"ROLE_FACILITATOR": 1,
+		"ROLE_VOTER":       2,
+		"ROLE_OBSERVER":    3,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_porker_resource_proto_enumTypes[3].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_porker_resource_proto_enumTypes[3]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{3}
+}
+
+type DeckType int32
+
+const (
+	// DECK_TYPE_UNKNOWN is treated as DECK_TYPE_FIBONACCI.
+	DeckType_DECK_TYPE_UNKNOWN            DeckType = 0
+	DeckType_DECK_TYPE_FIBONACCI          DeckType = 1
+	DeckType_DECK_TYPE_MODIFIED_FIBONACCI DeckType = 2
+	DeckType_DECK_TYPE_T_SHIRT            DeckType = 3
+	DeckType_DECK_TYPE_POWERS_OF_TWO      DeckType = 4
+	DeckType_DECK_TYPE_CUSTOM             DeckType = 5
+)
+
+// Enum value maps for DeckType.
+var (
+	DeckType_name = map[int32]string{
+		0: "DECK_TYPE_UNKNOWN",
+		1: "DECK_TYPE_FIBONACCI",
+		2: "DECK_TYPE_MODIFIED_FIBONACCI",
+		3: "DECK_TYPE_T_SHIRT",
+		4: "DECK_TYPE_POWERS_OF_TWO",
+		5: "DECK_TYPE_CUSTOM",
+	}
+	DeckType_value = map[string]int32{
+		"DECK_TYPE_UNKNOWN":            0,
+		"DECK_TYPE_FIBONACCI":          1,
+		"DECK_TYPE_MODIFIED_FIBONACCI": 2,
+		"DECK_TYPE_T_SHIRT":            3,
+		"DECK_TYPE_POWERS_OF_TWO":      4,
+		"DECK_TYPE_CUSTOM":             5,
+	}
+)
+
+func (x DeckType) Enum() *DeckType {
+	p := new(DeckType)
+	*p = x
+	return p
+}
+
+func (x DeckType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeckType) Descriptor() protoreflect.EnumDescriptor {
+	return file_porker_resource_proto_enumTypes[4].Descriptor()
+}
+
+func (DeckType) Type() protoreflect.EnumType {
+	return &file_porker_resource_proto_enumTypes[4]
+}
+
+func (x DeckType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeckType.Descriptor instead.
+func (DeckType) EnumDescriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{4}
+}
+
+type Login struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginId   string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *Login) Reset() {
+	*x = Login{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Login) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Login) ProtoMessage() {}
+
+func (x *Login) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Login.ProtoReflect.Descriptor instead.
+func (*Login) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Login) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *Login) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type Ballot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginId string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	Point   Point  `protobuf:"varint,2,opt,name=point,proto3,enum=porker.Point" json:"point,omitempty"`
+	// card is the label of the voted card of the room deck, or empty if the login has not voted.
+	// Either point or card can be given to vote.
+	Card string `protobuf:"bytes,3,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *Ballot) Reset() {
+	*x = Ballot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ballot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ballot) ProtoMessage() {}
+
+func (x *Ballot) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ballot.ProtoReflect.Descriptor instead.
+func (*Ballot) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Ballot) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *Ballot) GetPoint() Point {
+	if x != nil {
+		return x.Point
+	}
+	return Point_POINT_UNKNOWN
+}
+
+func (x *Ballot) GetCard() string {
+	if x != nil {
+		return x.Card
+	}
+	return ""
+}
+
+type Card struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// value is used for the statistics. Cards without a value, such as "?", are not counted.
+	Value *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Card) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Card) GetValue() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type Deck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type DeckType `protobuf:"varint,1,opt,name=type,proto3,enum=porker.DeckType" json:"type,omitempty"`
+	// cards are given only for DECK_TYPE_CUSTOM. The server fills in the cards of the built-in decks.
+	Cards []*Card `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+}
+
+func (x *Deck) Reset() {
+	*x = Deck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deck) ProtoMessage() {}
+
+func (x *Deck) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deck.ProtoReflect.Descriptor instead.
+func (*Deck) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Deck) GetType() DeckType {
+	if x != nil {
+		return x.Type
+	}
+	return DeckType_DECK_TYPE_UNKNOWN
+}
+
+func (x *Deck) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type PokerSituation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId        string    `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	MasterLoginId string    `protobuf:"bytes,2,opt,name=master_login_id,json=masterLoginId,proto3" json:"master_login_id,omitempty"`
+	State         RoomState `protobuf:"varint,3,opt,name=state,proto3,enum=porker.RoomState" json:"state,omitempty"`
+	Ballots       []*Ballot `protobuf:"bytes,4,rep,name=ballots,proto3" json:"ballots,omitempty"`
+	Deck          *Deck     `protobuf:"bytes,5,opt,name=deck,proto3" json:"deck,omitempty"`
+	// summary is computed by the server when the ballots are revealed, and cleared on reset.
+	Summary *VoteSummary `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	// stories are estimated in order. current_story_id is the story being estimated, or empty if there is none.
+	Stories        []*Story `protobuf:"bytes,7,rep,name=stories,proto3" json:"stories,omitempty"`
+	CurrentStoryId string   `protobuf:"bytes,8,opt,name=current_story_id,json=currentStoryId,proto3" json:"current_story_id,omitempty"`
+	// deadline is set by StartTimer while the ballots are turned down, and cleared when they are revealed or reset.
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Members  []*Member              `protobuf:"bytes,10,rep,name=members,proto3" json:"members,omitempty"`
+	// round_started_at is when the ballots of the current round were opened for voting, on creation and on reset.
+	RoundStartedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=round_started_at,json=roundStartedAt,proto3" json:"round_started_at,omitempty"`
+}
+
+func (x *PokerSituation) Reset() {
+	*x = PokerSituation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PokerSituation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PokerSituation) ProtoMessage() {}
+
+func (x *PokerSituation) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PokerSituation.ProtoReflect.Descriptor instead.
+func (*PokerSituation) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PokerSituation) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *PokerSituation) GetMasterLoginId() string {
+	if x != nil {
+		return x.MasterLoginId
+	}
+	return ""
+}
+
+func (x *PokerSituation) GetState() RoomState {
+	if x != nil {
+		return x.State
+	}
+	return RoomState_ROOM_STATE_UNKNOWN
+}
+
+func (x *PokerSituation) GetBallots() []*Ballot {
+	if x != nil {
+		return x.Ballots
+	}
+	return nil
+}
+
+func (x *PokerSituation) GetDeck() *Deck {
+	if x != nil {
+		return x.Deck
+	}
+	return nil
+}
+
+func (x *PokerSituation) GetSummary() *VoteSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *PokerSituation) GetStories() []*Story {
+	if x != nil {
+		return x.Stories
+	}
+	return nil
+}
+
+func (x *PokerSituation) GetCurrentStoryId() string {
+	if x != nil {
+		return x.CurrentStoryId
+	}
+	return ""
+}
+
+func (x *PokerSituation) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *PokerSituation) GetMembers() []*Member {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *PokerSituation) GetRoundStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RoundStartedAt
+	}
+	return nil
+}
+
+type Member struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginId string `protobuf:"bytes,1,opt,name=login_id,json=loginId,proto3" json:"login_id,omitempty"`
+	Role    Role   `protobuf:"varint,2,opt,name=role,proto3,enum=porker.Role" json:"role,omitempty"`
+}
+
+func (x *Member) Reset() {
+	*x = Member{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Member) ProtoMessage() {}
+
+func (x *Member) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Member.ProtoReflect.Descriptor instead.
+func (*Member) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Member) GetLoginId() string {
+	if x != nil {
+		return x.LoginId
+	}
+	return ""
+}
+
+func (x *Member) GetRole() Role {
+	if x != nil {
+		return x.Role
+	}
+	return Role_ROLE_UNKNOWN
+}
+
+type Story struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoryId     string `protobuf:"bytes,1,opt,name=story_id,json=storyId,proto3" json:"story_id,omitempty"`
+	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Link        string `protobuf:"bytes,3,opt,name=link,proto3" json:"link,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// estimate is the agreed card, recorded when the room is reset to the next story.
+	Estimate string `protobuf:"bytes,5,opt,name=estimate,proto3" json:"estimate,omitempty"`
+}
+
+func (x *Story) Reset() {
+	*x = Story{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Story) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Story) ProtoMessage() {}
+
+func (x *Story) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Story.ProtoReflect.Descriptor instead.
+func (*Story) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Story) GetStoryId() string {
+	if x != nil {
+		return x.StoryId
+	}
+	return ""
+}
+
+func (x *Story) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Story) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *Story) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Story) GetEstimate() string {
+	if x != nil {
+		return x.Estimate
+	}
+	return ""
+}
+
+// CardHolders are the logins that voted the card.
+type CardHolders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Card     string   `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Value    float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	LoginIds []string `protobuf:"bytes,3,rep,name=login_ids,json=loginIds,proto3" json:"login_ids,omitempty"`
+}
+
+func (x *CardHolders) Reset() {
+	*x = CardHolders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardHolders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardHolders) ProtoMessage() {}
+
+func (x *CardHolders) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardHolders.ProtoReflect.Descriptor instead.
+func (*CardHolders) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CardHolders) GetCard() string {
+	if x != nil {
+		return x.Card
+	}
+	return ""
+}
+
+func (x *CardHolders) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CardHolders) GetLoginIds() []string {
+	if x != nil {
+		return x.LoginIds
+	}
+	return nil
+}
+
+type VoteSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// voted_count is the number of ballots with a card, abstained_count of NOT_VOTE,
+	// and not_voted_count of the ballots that were revealed before voting.
+	VotedCount     int32 `protobuf:"varint,1,opt,name=voted_count,json=votedCount,proto3" json:"voted_count,omitempty"`
+	AbstainedCount int32 `protobuf:"varint,2,opt,name=abstained_count,json=abstainedCount,proto3" json:"abstained_count,omitempty"`
+	NotVotedCount  int32 `protobuf:"varint,3,opt,name=not_voted_count,json=notVotedCount,proto3" json:"not_voted_count,omitempty"`
+	// average, median, min, max and spread are computed from the cards with a value, and not set if there is none.
+	Average *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=average,proto3" json:"average,omitempty"`
+	Median  *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=median,proto3" json:"median,omitempty"`
+	Min     *CardHolders            `protobuf:"bytes,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max     *CardHolders            `protobuf:"bytes,7,opt,name=max,proto3" json:"max,omitempty"`
+	// spread is max - min.
+	Spread *wrapperspb.DoubleValue `protobuf:"bytes,8,opt,name=spread,proto3" json:"spread,omitempty"`
+	// mode is the most voted cards, including the cards without a value. Ties have more than one card.
+	Mode []string `protobuf:"bytes,9,rep,name=mode,proto3" json:"mode,omitempty"`
+	// consensus is true if every voted ballot has the same card.
+	Consensus bool `protobuf:"varint,10,opt,name=consensus,proto3" json:"consensus,omitempty"`
+}
+
+func (x *VoteSummary) Reset() {
+	*x = VoteSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteSummary) ProtoMessage() {}
+
+func (x *VoteSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteSummary.ProtoReflect.Descriptor instead.
+func (*VoteSummary) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VoteSummary) GetVotedCount() int32 {
+	if x != nil {
+		return x.VotedCount
+	}
+	return 0
+}
+
+func (x *VoteSummary) GetAbstainedCount() int32 {
+	if x != nil {
+		return x.AbstainedCount
+	}
+	return 0
+}
+
+func (x *VoteSummary) GetNotVotedCount() int32 {
+	if x != nil {
+		return x.NotVotedCount
+	}
+	return 0
+}
+
+func (x *VoteSummary) GetAverage() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Average
+	}
+	return nil
+}
+
+func (x *VoteSummary) GetMedian() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Median
+	}
+	return nil
+}
+
+func (x *VoteSummary) GetMin() *CardHolders {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *VoteSummary) GetMax() *CardHolders {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *VoteSummary) GetSpread() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Spread
+	}
+	return nil
+}
+
+func (x *VoteSummary) GetMode() []string {
+	if x != nil {
+		return x.Mode
+	}
+	return nil
+}
+
+func (x *VoteSummary) GetConsensus() bool {
+	if x != nil {
+		return x.Consensus
+	}
+	return false
+}
+
+type RoomSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SnapshotId  string                 `protobuf:"bytes,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	PublishedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	Situation   *PokerSituation        `protobuf:"bytes,3,opt,name=situation,proto3" json:"situation,omitempty"`
+}
+
+func (x *RoomSnapshot) Reset() {
+	*x = RoomSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoomSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSnapshot) ProtoMessage() {}
+
+func (x *RoomSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSnapshot.ProtoReflect.Descriptor instead.
+func (*RoomSnapshot) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RoomSnapshot) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *RoomSnapshot) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *RoomSnapshot) GetSituation() *PokerSituation {
+	if x != nil {
+		return x.Situation
+	}
+	return nil
+}
+
+// ArchivedRound is a revealed round kept after the room has timed out.
+type ArchivedRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	// story is the title of the estimated story, or empty if the room did not have one.
+	Story      string                 `protobuf:"bytes,2,opt,name=story,proto3" json:"story,omitempty"`
+	Ballots    []*Ballot              `protobuf:"bytes,3,rep,name=ballots,proto3" json:"ballots,omitempty"`
+	StartedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	RevealedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=revealed_at,json=revealedAt,proto3" json:"revealed_at,omitempty"`
+}
+
+func (x *ArchivedRound) Reset() {
+	*x = ArchivedRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_porker_resource_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivedRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivedRound) ProtoMessage() {}
+
+func (x *ArchivedRound) ProtoReflect() protoreflect.Message {
+	mi := &file_porker_resource_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivedRound.ProtoReflect.Descriptor instead.
+func (*ArchivedRound) Descriptor() ([]byte, []int) {
+	return file_porker_resource_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ArchivedRound) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
+}
+
+func (x *ArchivedRound) GetStory() string {
+	if x != nil {
+		return x.Story
+	}
+	return ""
+}
+
+func (x *ArchivedRound) GetBallots() []*Ballot {
+	if x != nil {
+		return x.Ballots
+	}
+	return nil
+}
+
+func (x *ArchivedRound) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ArchivedRound) GetRevealedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevealedAt
+	}
+	return nil
+}
+
+var File_porker_resource_proto protoreflect.FileDescriptor
+
+var file_porker_resource_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x41, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x06, 0x42, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x72,
+	0x64, 0x22, 0x50, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x50, 0x0a, 0x04, 0x44, 0x65, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x22, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x05,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0xf0, 0x03, 0x0a, 0x0e, 0x50, 0x6f, 0x6b, 0x65, 0x72, 0x53,
+	0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c,
+	0x6c, 0x6f, 0x74, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x04,
+	0x64, 0x65, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x6b, 0x52, 0x04, 0x64, 0x65, 0x63, 0x6b, 0x12, 0x2d,
+	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x45, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22,
+	0x8a, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22, 0x54, 0x0a, 0x0b,
+	0x43, 0x61, 0x72, 0x64, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x49,
+	0x64, 0x73, 0x22, 0xa3, 0x03, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x62,
+	0x73, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x06,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x6e, 0x12, 0x25, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x48, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x03, 0x6d, 0x61, 0x78,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e,
+	0x43, 0x61, 0x72, 0x64, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x12, 0x34, 0x0a, 0x06, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x73, 0x69, 0x74,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6f, 0x6b, 0x65, 0x72, 0x53, 0x69, 0x74, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x69, 0x74, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xe0, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x28, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x6c, 0x6c, 0x6f,
+	0x74, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x2a, 0x25, 0x0a, 0x0a, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x56, 0x49,
+	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x2a, 0x52, 0x0a, 0x09, 0x52, 0x6f, 0x6f,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x54, 0x55, 0x52,
+	0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f, 0x4f, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x02, 0x2a, 0xd8, 0x01,
+	0x0a, 0x05, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x30, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x48, 0x41, 0x4c, 0x46, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x49, 0x4e, 0x54,
+	0x5f, 0x31, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x32, 0x10,
+	0x04, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x33, 0x10, 0x05, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x35, 0x10, 0x06, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x38, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x49, 0x4e,
+	0x54, 0x5f, 0x31, 0x33, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
+	0x32, 0x31, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x4f,
+	0x46, 0x46, 0x45, 0x45, 0x10, 0x14, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x15, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x62, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f,
+	0x54, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x63, 0x2a, 0x51, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x46, 0x41, 0x43, 0x49, 0x4c,
+	0x49, 0x54, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x56, 0x4f, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x4f, 0x42, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x03, 0x2a, 0xa6, 0x01, 0x0a, 0x08,
+	0x44, 0x65, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x43, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x44, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x42,
+	0x4f, 0x4e, 0x41, 0x43, 0x43, 0x49, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x45, 0x43, 0x4b,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45, 0x44, 0x5f, 0x46,
+	0x49, 0x42, 0x4f, 0x4e, 0x41, 0x43, 0x43, 0x49, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45,
+	0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x5f, 0x53, 0x48, 0x49, 0x52, 0x54, 0x10,
+	0x03, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x4f, 0x57, 0x45, 0x52, 0x53, 0x5f, 0x4f, 0x46, 0x5f, 0x54, 0x57, 0x4f, 0x10, 0x04, 0x12, 0x14,
+	0x0a, 0x10, 0x44, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54,
+	0x4f, 0x4d, 0x10, 0x05, 0x42, 0x0f, 0x5a, 0x0d, 0x70, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x3b, 0x70,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_porker_resource_proto_rawDescOnce sync.Once
+	file_porker_resource_proto_rawDescData = file_porker_resource_proto_rawDesc
+)
+
+func file_porker_resource_proto_rawDescGZIP() []byte {
+	file_porker_resource_proto_rawDescOnce.Do(func() {
+		file_porker_resource_proto_rawDescData = protoimpl.X.CompressGZIP(file_porker_resource_proto_rawDescData)
+	})
+	return file_porker_resource_proto_rawDescData
+}
+
+var file_porker_resource_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_porker_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_porker_resource_proto_goTypes = []interface{}{
+	(Violations)(0),                // 0: porker.Violations
+	(RoomState)(0),                 // 1: porker.RoomState
+	(Point)(0),                     // 2: porker.Point
+	(Role)(0),                      // 3: porker.Role
+	(DeckType)(0),                  // 4: porker.DeckType
+	(*Login)(nil),                  // 5: porker.Login
+	(*Ballot)(nil),                 // 6: porker.Ballot
+	(*Card)(nil),                   // 7: porker.Card
+	(*Deck)(nil),                   // 8: porker.Deck
+	(*PokerSituation)(nil),         // 9: porker.PokerSituation
+	(*Member)(nil),                 // 10: porker.Member
+	(*Story)(nil),                  // 11: porker.Story
+	(*CardHolders)(nil),            // 12: porker.CardHolders
+	(*VoteSummary)(nil),            // 13: porker.VoteSummary
+	(*RoomSnapshot)(nil),           // 14: porker.RoomSnapshot
+	(*ArchivedRound)(nil),          // 15: porker.ArchivedRound
+	(*wrapperspb.DoubleValue)(nil), // 16: google.protobuf.DoubleValue
+	(*timestamppb.Timestamp)(nil),  // 17: google.protobuf.Timestamp
+}
+var file_porker_resource_proto_depIdxs = []int32{
+	2,  // 0: porker.Ballot.point:type_name -> porker.Point
+	16, // 1: porker.Card.value:type_name -> google.protobuf.DoubleValue
+	4,  // 2: porker.Deck.type:type_name -> porker.DeckType
+	7,  // 3: porker.Deck.cards:type_name -> porker.Card
+	1,  // 4: porker.PokerSituation.state:type_name -> porker.RoomState
+	6,  // 5: porker.PokerSituation.ballots:type_name -> porker.Ballot
+	8,  // 6: porker.PokerSituation.deck:type_name -> porker.Deck
+	13, // 7: porker.PokerSituation.summary:type_name -> porker.VoteSummary
+	11, // 8: porker.PokerSituation.stories:type_name -> porker.Story
+	17, // 9: porker.PokerSituation.deadline:type_name -> google.protobuf.Timestamp
+	10, // 10: porker.PokerSituation.members:type_name -> porker.Member
+	17, // 11: porker.PokerSituation.round_started_at:type_name -> google.protobuf.Timestamp
+	3,  // 12: porker.Member.role:type_name -> porker.Role
+	16, // 13: porker.VoteSummary.average:type_name -> google.protobuf.DoubleValue
+	16, // 14: porker.VoteSummary.median:type_name -> google.protobuf.DoubleValue
+	12, // 15: porker.VoteSummary.min:type_name -> porker.CardHolders
+	12, // 16: porker.VoteSummary.max:type_name -> porker.CardHolders
+	16, // 17: porker.VoteSummary.spread:type_name -> google.protobuf.DoubleValue
+	17, // 18: porker.RoomSnapshot.published_at:type_name -> google.protobuf.Timestamp
+	9,  // 19: porker.RoomSnapshot.situation:type_name -> porker.PokerSituation
+	6,  // 20: porker.ArchivedRound.ballots:type_name -> porker.Ballot
+	17, // 21: porker.ArchivedRound.started_at:type_name -> google.protobuf.Timestamp
+	17, // 22: porker.ArchivedRound.revealed_at:type_name -> google.protobuf.Timestamp
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_porker_resource_proto_init() }
+func file_porker_resource_proto_init() {
+	if File_porker_resource_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_porker_resource_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Login); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ballot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Card); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Deck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PokerSituation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Member); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Story); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardHolders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoomSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_porker_resource_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivedRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_porker_resource_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_porker_resource_proto_goTypes,
+		DependencyIndexes: file_porker_resource_proto_depIdxs,
+		EnumInfos:         file_porker_resource_proto_enumTypes,
+		MessageInfos:      file_porker_resource_proto_msgTypes,
+	}.Build()
+	File_porker_resource_proto = out.File
+	file_porker_resource_proto_rawDesc = nil
+	file_porker_resource_proto_goTypes = nil
+	file_porker_resource_proto_depIdxs = nil
+}